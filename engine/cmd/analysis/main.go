@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image/png"
+	"log"
+	"os"
+
+	"github.com/goofr-group/jump-master/engine/internal/analysis"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+)
+
+// main entry point for the map reachability analysis. It simulates the possible jumps from every surface of the map,
+// prints a summary and writes the report and the overlay image.
+func main() {
+	reportPath := flag.String("report", "", "path of the file to write the JSON report to")
+	overlayPath := flag.String("overlay", "", "path of the file to write the PNG overlay image to")
+	chargeLevels := flag.Int("levels", 10, "number of jump charges simulated, from no charge to the full charge")
+	samples := flag.Int("samples", 3, "number of positions on each surface the jumps are simulated from")
+	scale := flag.Float64("scale", 0.5, "pixels per world unit of the overlay image")
+	flag.Parse()
+
+	options := analysis.Options{
+		ChargeLevels: *chargeLevels,
+		Samples:      *samples,
+	}
+
+	if err := run(*reportPath, *overlayPath, options, *scale); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run analyses the map of the game configuration.
+func run(reportPath, overlayPath string, options analysis.Options, scale float64) error {
+	// Load configurations.
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		return fmt.Errorf("failed to load engine configuration: %w", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		return fmt.Errorf("failed to load player configuration: %w", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		return fmt.Errorf("failed to load map configuration: %w", err)
+	}
+
+	report := analysis.Analyze(engineConfig, playerConfig, mapConfig, options)
+
+	log.Printf("surfaces: %d, jumps: %d, unreachable: %v, dead ends: %v, goal path: %d jumps",
+		len(report.Surfaces), len(report.Edges), report.Unreachable, report.DeadEnds, len(report.GoalPath))
+
+	// Write the report.
+	if len(reportPath) != 0 {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal report: %w", err)
+		}
+
+		if err := os.WriteFile(reportPath, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
+	// Write the overlay image.
+	if len(overlayPath) != 0 {
+		file, err := os.Create(overlayPath)
+		if err != nil {
+			return fmt.Errorf("failed to create overlay image: %w", err)
+		}
+		defer file.Close()
+
+		if err := png.Encode(file, analysis.Overlay(mapConfig, report, scale)); err != nil {
+			return fmt.Errorf("failed to encode overlay image: %w", err)
+		}
+	}
+
+	return nil
+}
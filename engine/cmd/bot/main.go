@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/goofr-group/jump-master/engine/internal/bot"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// main entry point for the route solver. It searches for the inputs that climb the map to the target height, prints
+// the result and writes the replay of the route. Exits with status 1 if the target height is not reached, so that it
+// can be used to check that the map is still climbable.
+func main() {
+	replayPath := flag.String("replay", "", "path of the file to write the replay of the route to")
+	targetHeight := flag.Float64("height", 0, "position on the y-axis to reach, or 0 for the highest surface of the map")
+	beamWidth := flag.Int("beam", 8, "number of landing states kept after each jump")
+	maxJumps := flag.Int("jumps", 100, "maximum number of jumps of the route")
+	chargeLevels := flag.Int("levels", 8, "number of jump charges tried")
+	walkTicks := flag.Int("walk", 10, "number of physics steps walked to each side before jumping, or 0 to only jump from the landing position")
+	flag.Parse()
+
+	options := bot.Options{
+		TargetHeight: *targetHeight,
+		BeamWidth:    *beamWidth,
+		MaxJumps:     *maxJumps,
+		ChargeLevels: *chargeLevels,
+		WalkTicks:    *walkTicks,
+	}
+
+	if err := run(*replayPath, options); err != nil {
+		if errors.Is(err, bot.ErrUnsolved) {
+			log.Printf("route not found: %v", err)
+			os.Exit(1)
+		}
+
+		log.Fatal(err)
+	}
+}
+
+// run solves the map of the game configuration.
+func run(replayPath string, options bot.Options) error {
+	// Load configurations.
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		return fmt.Errorf("failed to load engine configuration: %w", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		return fmt.Errorf("failed to load player configuration: %w", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		return fmt.Errorf("failed to load map configuration: %w", err)
+	}
+
+	configVersion, err := config.Version()
+	if err != nil {
+		return fmt.Errorf("failed to get configuration version: %w", err)
+	}
+
+	// Solve the route, keeping the best route found when the target height is not reached.
+	r, solveErr := bot.New(engineConfig, playerConfig, mapConfig, configVersion, options).Solve()
+	if solveErr != nil && !errors.Is(solveErr, bot.ErrUnsolved) {
+		return solveErr
+	}
+
+	log.Printf("ticks: %d, time: %.3fs, height: %.3f, level: %d", r.Result.Ticks, r.Result.Time, r.Result.Height, r.Result.Level)
+
+	// Write the replay of the route.
+	if len(replayPath) != 0 {
+		if err := replay.Save(replayPath, r); err != nil {
+			return fmt.Errorf("failed to save replay: %w", err)
+		}
+	}
+
+	return solveErr
+}
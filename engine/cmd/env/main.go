@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/env"
+)
+
+// Request types.
+const (
+	requestReset = "reset"
+	requestStep  = "step"
+)
+
+// request defines the structure of each request read from the standard input.
+type request struct {
+	Type   string     `json:"type"`
+	Seed   int64      `json:"seed"`   // Defines the seed of the episode in reset requests.
+	Action env.Action `json:"action"` // Defines the action held in step requests.
+}
+
+// response defines the structure of each response written to the standard output.
+type response struct {
+	Observation env.Observation `json:"observation"`
+	Reward      float64         `json:"reward"`
+	Done        bool            `json:"done"`
+	Error       *string         `json:"error"`
+}
+
+// main entry point for the reinforcement learning environment. It reads one JSON request per line from the standard
+// input and writes one JSON response per line to the standard output, so that it can be driven by external trainers.
+func main() {
+	position := flag.Bool("position", true, "observe the player position")
+	velocity := flag.Bool("velocity", true, "observe the player velocity")
+	grounded := flag.Bool("grounded", true, "observe the player ground contact")
+	charge := flag.Bool("charge", true, "observe the jump charge")
+	gridRadius := flag.Int("grid", 3, "radius, in tiles, of the collision grid observed around the player, or 0 to not observe it")
+	frameSkip := flag.Int("frameskip", 1, "number of physics steps each action is held for")
+	maxSteps := flag.Int("steps", 0, "maximum number of steps of an episode, or 0 for unlimited episodes")
+	targetHeight := flag.Float64("height", 0, "position on the y-axis that ends the episode, or 0 to not end episodes by height")
+	randomSpawn := flag.Bool("random-spawn", false, "spawn the player on a random surface chosen with the seed of the episode")
+	flag.Parse()
+
+	options := env.Options{
+		Observation: env.ObservationConfig{
+			Position:   *position,
+			Velocity:   *velocity,
+			Grounded:   *grounded,
+			Charge:     *charge,
+			GridRadius: *gridRadius,
+		},
+		FrameSkip:    *frameSkip,
+		MaxSteps:     *maxSteps,
+		TargetHeight: *targetHeight,
+		RandomSpawn:  *randomSpawn,
+	}
+
+	if err := run(os.Stdin, os.Stdout, options); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves the requests of the given reader until it is closed.
+func run(r io.Reader, w io.Writer, options env.Options) error {
+	// Load configurations.
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		return fmt.Errorf("failed to load engine configuration: %w", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		return fmt.Errorf("failed to load player configuration: %w", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		return fmt.Errorf("failed to load map configuration: %w", err)
+	}
+
+	e := env.New(engineConfig, playerConfig, mapConfig, options)
+
+	scanner := bufio.NewScanner(r)
+	encoder := json.NewEncoder(w)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var res response
+		if err := handle(e, scanner.Bytes(), &res); err != nil {
+			message := err.Error()
+			res.Error = &message
+		}
+
+		if err := encoder.Encode(res); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+
+	return nil
+}
+
+// handle handles the given request and fills the given response.
+func handle(e *env.Env, data []byte, res *response) error {
+	var req request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+
+	var err error
+	switch req.Type {
+	case requestReset:
+		res.Observation, err = e.Reset(req.Seed)
+	case requestStep:
+		res.Observation, res.Reward, res.Done, err = e.Step(req.Action)
+	default:
+		err = errors.New("unexpected request type")
+	}
+
+	return err
+}
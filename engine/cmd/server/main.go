@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/race"
+)
+
+const (
+	// racePath defines the path of the race WebSocket endpoint.
+	racePath = "/race"
+
+	// shutdownTimeout defines the time to wait for the connections to close on shutdown.
+	shutdownTimeout = 5 * time.Second
+)
+
+// main entry point for the authoritative race server.
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	if err := run(*addr); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run loads the configurations and serves the race server until an interrupt signal is received.
+func run(addr string) error {
+	// Load configurations.
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		return fmt.Errorf("failed to load engine configuration: %w", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		return fmt.Errorf("failed to load player configuration: %w", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		return fmt.Errorf("failed to load map configuration: %w", err)
+	}
+
+	// Set up race server.
+	raceServer := race.NewServer(engineConfig, playerConfig, mapConfig)
+	defer raceServer.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle(racePath, raceServer)
+
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("race server listening on %s%s", addr, racePath)
+		errs <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err = <-errs:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("failed to serve: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	// Shut down gracefully.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err = httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/leaderboard"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+	"github.com/goofr-group/jump-master/engine/internal/verifier"
+)
+
+// main entry point for the replay verifier. It re-simulates the submitted replay, prints the simulated result and,
+// if the replay is valid and a leaderboard is given, adds the run to the leaderboard.
+func main() {
+	replayPath := flag.String("replay", "", "path of the replay to verify")
+	name := flag.String("name", "", "name of the player that submitted the replay")
+	leaderboardPath := flag.String("leaderboard", "", "path of the leaderboard file to add the verified run to")
+	tracePath := flag.String("trace", "", "path of the file to write the ghost trace of the verified run to")
+	flag.Parse()
+
+	if len(*replayPath) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*replayPath, *name, *leaderboardPath, *tracePath); err != nil {
+		if errors.Is(err, verifier.ErrConfigVersion) || errors.Is(err, verifier.ErrDiverged) ||
+			errors.Is(err, verifier.ErrInvalidInputs) {
+			log.Printf("replay rejected: %v", err)
+			os.Exit(1)
+		}
+
+		log.Fatal(err)
+	}
+}
+
+// run verifies the replay in the specified path.
+func run(replayPath, name, leaderboardPath, tracePath string) error {
+	// Load configurations.
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		return fmt.Errorf("failed to load engine configuration: %w", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		return fmt.Errorf("failed to load player configuration: %w", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		return fmt.Errorf("failed to load map configuration: %w", err)
+	}
+
+	configVersion, err := config.Version()
+	if err != nil {
+		return fmt.Errorf("failed to get configuration version: %w", err)
+	}
+
+	// Load and verify the replay.
+	r, err := replay.Load(replayPath)
+	if err != nil {
+		return fmt.Errorf("failed to load replay: %w", err)
+	}
+
+	v := verifier.New(engineConfig, playerConfig, mapConfig, configVersion)
+
+	result, err := v.Verify(r)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	fmt.Println(string(data))
+
+	// Write the ghost trace of the verified run.
+	if len(tracePath) != 0 {
+		trace, err := v.Trace(name, r.Inputs)
+		if err != nil {
+			return fmt.Errorf("failed to trace replay: %w", err)
+		}
+
+		data, err := json.Marshal(trace)
+		if err != nil {
+			return fmt.Errorf("failed to marshal trace: %w", err)
+		}
+
+		if err = os.WriteFile(tracePath, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write trace: %w", err)
+		}
+	}
+
+	// Add the verified run to the leaderboard.
+	if len(leaderboardPath) == 0 {
+		return nil
+	}
+
+	err = leaderboard.NewStore(leaderboardPath).Add(leaderboard.Entry{
+		Name:          name,
+		ConfigVersion: configVersion,
+		Ticks:         result.Ticks,
+		Time:          result.Time,
+		Height:        result.Height,
+		Level:         result.Level,
+		Completed:     result.Completed,
+		SubmittedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add leaderboard entry: %w", err)
+	}
+
+	return nil
+}
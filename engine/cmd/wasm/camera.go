@@ -0,0 +1,31 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// jsSetCameraShake enables or disables the camera shake.
+func jsSetCameraShake(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in set camera shake")
+			}
+
+			if args[0].Type() != js.TypeBoolean {
+				return errors.New("unexpected boolean type")
+			}
+
+			app.SetCameraShake(args[0].Bool())
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
@@ -0,0 +1,28 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// jsDebug returns the internal state of the behaviours and physics of the game world.
+func jsDebug(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		// The debug state is only used for diagnostics, so it is serialized through JSON instead of being mapped field
+		// by field.
+		data, err := json.Marshal(app.Debug())
+		if err != nil {
+			return marshalErrorResponse(fmt.Errorf("failed to marshal debug state: %w", err))
+		}
+
+		response := js.Global().Get("JSON").Call("parse", string(data))
+		response.Set("error", js.Null())
+
+		return response
+	})
+}
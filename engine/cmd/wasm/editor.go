@@ -0,0 +1,128 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// Formats of the exported map.
+const (
+	mapFormatConfig       = "config"       // The map configuration format, used by the engine.
+	mapFormatSpriteFusion = "spritefusion" // The Sprite Fusion project format, used by the map editor.
+)
+
+// jsSetTile places a tile in a layer of the map.
+func jsSetTile(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 4 {
+				return errors.New("unexpected number of arguments in set tile")
+			}
+
+			layer, x, y, err := unmarshalTileRequest(args[:3])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal tile request: %w", err)
+			}
+
+			if args[3].Type() != js.TypeString {
+				return errors.New("unexpected string type")
+			}
+
+			if err := app.SetTile(layer, x, y, args[3].String()); err != nil {
+				return fmt.Errorf("failed to set tile: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsRemoveTile removes a tile from a layer of the map.
+func jsRemoveTile(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 3 {
+				return errors.New("unexpected number of arguments in remove tile")
+			}
+
+			layer, x, y, err := unmarshalTileRequest(args)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal tile request: %w", err)
+			}
+
+			if err := app.RemoveTile(layer, x, y); err != nil {
+				return fmt.Errorf("failed to remove tile: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsExportMap exports the current map, including the edited tiles, as JSON in the given format.
+func jsExportMap(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var data []byte
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in export map")
+			}
+
+			if args[0].Type() != js.TypeString {
+				return errors.New("unexpected string type")
+			}
+
+			var value interface{}
+			switch format := args[0].String(); format {
+			case mapFormatConfig:
+				value = app.Map()
+
+			case mapFormatSpriteFusion:
+				project, err := app.ExportSpriteFusion()
+				if err != nil {
+					return err
+				}
+				value = project
+
+			default:
+				return fmt.Errorf("unexpected map format %q", format)
+			}
+
+			var err error
+			data, err = json.MarshalIndent(value, "", "    ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal map: %w", err)
+			}
+
+			return nil
+		}()
+
+		response := marshalErrorResponse(err)
+		response["data"] = string(data)
+
+		return response
+	})
+}
+
+// unmarshalTileRequest deserializes the layer name and the map coordinates of a tile.
+func unmarshalTileRequest(args []js.Value) (string, int, int, error) {
+	if args[0].Type() != js.TypeString {
+		return "", 0, 0, errors.New("unexpected string type")
+	}
+
+	if args[1].Type() != js.TypeNumber || args[2].Type() != js.TypeNumber {
+		return "", 0, 0, errors.New("unexpected number type")
+	}
+
+	return args[0].String(), args[1].Int(), args[2].Int(), nil
+}
@@ -0,0 +1,80 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// jsStepFixed advances the game world by the given number of physics updates with the given actions.
+func jsStepFixed(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var debugStep domain.DebugStep
+		err := func() error {
+			if len(args) != 2 {
+				return errors.New("unexpected number of arguments in step fixed")
+			}
+
+			if args[0].Type() != js.TypeNumber {
+				return errors.New("unexpected number type")
+			}
+
+			request, err := unmarshalStepRequest(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal step request: %w", err)
+			}
+
+			debugStep, err = app.StepFixed(request.Actions, args[0].Int())
+			if err != nil {
+				return fmt.Errorf("failed to perform physics updates: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalDebugStepResponse(debugStep, err)
+	})
+}
+
+// jsStepFrame performs a single update pass without advancing the physics.
+func jsStepFrame(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		debugStep, err := app.StepFrame()
+		if err != nil {
+			err = fmt.Errorf("failed to perform update pass: %w", err)
+		}
+
+		return marshalDebugStepResponse(debugStep, err)
+	})
+}
+
+// marshalDebugStepResponse serializes the debug step response and returns a javascript object with the game state
+// information and the collisions that occurred.
+func marshalDebugStepResponse(debugStep domain.DebugStep, err error) map[string]interface{} {
+	response := marshalStepResponse(debugStep.GameState, err)
+	response["collisions"] = marshalCollisions(debugStep.Collisions)
+
+	return response
+}
+
+func marshalCollisions(collisions []domain.CollisionDebug) []interface{} {
+	response := make([]interface{}, len(collisions))
+	for i, c := range collisions {
+		response[i] = map[string]interface{}{
+			"playerId":      c.PlayerID,
+			"step":          c.Step,
+			"otherId":       c.OtherID,
+			"otherTag":      c.OtherTag,
+			"enter":         c.Enter,
+			"contactPoints": marshalVectors2(c.ContactPoints),
+			"normal":        marshalVector2(c.Normal),
+		}
+	}
+
+	return response
+}
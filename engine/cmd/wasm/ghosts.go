@@ -0,0 +1,65 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// jsLoadGhosts loads the ghost runs rendered alongside the players.
+func jsLoadGhosts(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in load ghosts")
+			}
+
+			traces, err := unmarshalLoadGhostsRequest(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal load ghosts request: %w", err)
+			}
+
+			err = app.LoadGhosts(traces)
+			if err != nil {
+				return fmt.Errorf("failed to load ghosts: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// unmarshalLoadGhostsRequest deserializes the load ghosts request, which is a JSON string with the list of traces.
+func unmarshalLoadGhostsRequest(value js.Value) ([]replay.Trace, error) {
+	if value.Type() != js.TypeString {
+		return nil, errors.New("unexpected string type")
+	}
+
+	var traces []replay.Trace
+	if err := json.Unmarshal([]byte(value.String()), &traces); err != nil {
+		return nil, err
+	}
+
+	return traces, nil
+}
+
+// marshalErrorResponse serializes a response that only contains the error that occurred, if any.
+func marshalErrorResponse(err error) map[string]interface{} {
+	response := map[string]interface{}{
+		"error": nil,
+	}
+
+	if err != nil {
+		response["error"] = err.Error()
+	}
+
+	return response
+}
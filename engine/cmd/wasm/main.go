@@ -15,8 +15,28 @@ const (
 	entryPoint = "engine"
 
 	// Name of the methods within the entry point object.
-	methodVersion = "version"
-	methodStep    = "step"
+	methodVersion        = "version"
+	methodStep           = "step"
+	methodLoadGhosts     = "loadGhosts"
+	methodSetOpponents   = "setOpponents"
+	methodSetCameraShake = "setCameraShake"
+	methodResize         = "resize"
+	methodDebug          = "debug"
+	methodPause          = "pause"
+	methodResume         = "resume"
+	methodSetTimeScale   = "setTimeScale"
+	methodStepFixed      = "stepFixed"
+	methodStepFrame      = "stepFrame"
+	methodPredictJump    = "predictJump"
+	methodSetPractice    = "setPracticeMode"
+	methodTeleport       = "teleport"
+	methodTeleportLevel  = "teleportLevel"
+	methodSaveBookmark   = "saveBookmark"
+	methodLoadBookmark   = "loadBookmark"
+	methodSetTile        = "setTile"
+	methodRemoveTile     = "removeTile"
+	methodExportMap      = "exportMap"
+	methodExportReplay   = "exportReplay"
 )
 
 // Build metadata to be set on compile-time.
@@ -56,6 +76,26 @@ func main() {
 	module := js.Global().Get(entryPoint)
 	module.Set(methodVersion, jsVersion(GoVersion, Version, GitCommit, Build))
 	module.Set(methodStep, jsStep(app))
+	module.Set(methodLoadGhosts, jsLoadGhosts(app))
+	module.Set(methodSetOpponents, jsSetOpponents(app))
+	module.Set(methodSetCameraShake, jsSetCameraShake(app))
+	module.Set(methodResize, jsResize(app))
+	module.Set(methodDebug, jsDebug(app))
+	module.Set(methodPause, jsPause(app))
+	module.Set(methodResume, jsResume(app))
+	module.Set(methodSetTimeScale, jsSetTimeScale(app))
+	module.Set(methodStepFixed, jsStepFixed(app))
+	module.Set(methodStepFrame, jsStepFrame(app))
+	module.Set(methodPredictJump, jsPredictJump(app))
+	module.Set(methodSetPractice, jsSetPracticeMode(app))
+	module.Set(methodTeleport, jsTeleport(app))
+	module.Set(methodTeleportLevel, jsTeleportLevel(app))
+	module.Set(methodSaveBookmark, jsSaveBookmark(app))
+	module.Set(methodLoadBookmark, jsLoadBookmark(app))
+	module.Set(methodSetTile, jsSetTile(app))
+	module.Set(methodRemoveTile, jsRemoveTile(app))
+	module.Set(methodExportMap, jsExportMap(app))
+	module.Set(methodExportReplay, jsExportReplay(app))
 
 	// Set up game world.
 	err = app.StartGameWorld()
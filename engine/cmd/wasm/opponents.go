@@ -0,0 +1,53 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// jsSetOpponents updates the opponents of a race rendered as ghosts.
+func jsSetOpponents(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in set opponents")
+			}
+
+			opponents, err := unmarshalSetOpponentsRequest(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal set opponents request: %w", err)
+			}
+
+			err = app.SetOpponents(opponents)
+			if err != nil {
+				return fmt.Errorf("failed to set opponents: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// unmarshalSetOpponentsRequest deserializes the set opponents request, which is a JSON string with the list of
+// opponents.
+func unmarshalSetOpponentsRequest(value js.Value) ([]domain.Opponent, error) {
+	if value.Type() != js.TypeString {
+		return nil, errors.New("unexpected string type")
+	}
+
+	var opponents []domain.Opponent
+	if err := json.Unmarshal([]byte(value.String()), &opponents); err != nil {
+		return nil, err
+	}
+
+	return opponents, nil
+}
@@ -0,0 +1,140 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// jsSetPracticeMode enables or disables the practice mode.
+func jsSetPracticeMode(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in set practice mode")
+			}
+
+			if args[0].Type() != js.TypeBoolean {
+				return errors.New("unexpected boolean type")
+			}
+
+			app.SetPracticeMode(args[0].Bool())
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsTeleport moves a player to a position in world space.
+func jsTeleport(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 3 {
+				return errors.New("unexpected number of arguments in teleport")
+			}
+
+			for _, arg := range args {
+				if arg.Type() != js.TypeNumber {
+					return errors.New("unexpected number type")
+				}
+			}
+
+			position := vector2.Vector2{X: args[1].Float(), Y: args[2].Float()}
+			if err := app.Teleport(args[0].Int(), position); err != nil {
+				return fmt.Errorf("failed to teleport: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsTeleportLevel moves a player to a screen level.
+func jsTeleportLevel(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 2 {
+				return errors.New("unexpected number of arguments in teleport level")
+			}
+
+			if args[0].Type() != js.TypeNumber || args[1].Type() != js.TypeNumber {
+				return errors.New("unexpected number type")
+			}
+
+			if err := app.TeleportLevel(args[0].Int(), args[1].Int()); err != nil {
+				return fmt.Errorf("failed to teleport to level: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsSaveBookmark saves the state of a player in a named bookmark.
+func jsSaveBookmark(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			player, name, err := unmarshalBookmarkRequest(args)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal bookmark request: %w", err)
+			}
+
+			if err := app.SaveBookmark(player, name); err != nil {
+				return fmt.Errorf("failed to save bookmark: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// jsLoadBookmark restores the state of a player from a named bookmark.
+func jsLoadBookmark(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			player, name, err := unmarshalBookmarkRequest(args)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal bookmark request: %w", err)
+			}
+
+			if err := app.LoadBookmark(player, name); err != nil {
+				return fmt.Errorf("failed to load bookmark: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
+
+// unmarshalBookmarkRequest deserializes the player index and the bookmark name.
+func unmarshalBookmarkRequest(args []js.Value) (int, string, error) {
+	if len(args) != 2 {
+		return 0, "", errors.New("unexpected number of arguments")
+	}
+
+	if args[0].Type() != js.TypeNumber {
+		return 0, "", errors.New("unexpected number type")
+	}
+
+	if args[1].Type() != js.TypeString {
+		return 0, "", errors.New("unexpected string type")
+	}
+
+	return args[0].Int(), args[1].String(), nil
+}
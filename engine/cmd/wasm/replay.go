@@ -0,0 +1,47 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+)
+
+// jsExportReplay exports the replay of the current run, to be submitted to the replay verifier.
+func jsExportReplay(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var data []byte
+		err := func() error {
+			if len(args) != 0 {
+				return errors.New("unexpected number of arguments in export replay")
+			}
+
+			configVersion, err := config.Version()
+			if err != nil {
+				return fmt.Errorf("failed to get configuration version: %w", err)
+			}
+
+			r, err := app.Replay(configVersion)
+			if err != nil {
+				return err
+			}
+
+			data, err = json.Marshal(r)
+			if err != nil {
+				return fmt.Errorf("failed to marshal replay: %w", err)
+			}
+
+			return nil
+		}()
+
+		response := marshalErrorResponse(err)
+		response["data"] = string(data)
+
+		return response
+	})
+}
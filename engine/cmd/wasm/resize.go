@@ -0,0 +1,35 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// jsResize resizes the camera to the given viewport size.
+func jsResize(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 2 {
+				return errors.New("unexpected number of arguments in resize")
+			}
+
+			if args[0].Type() != js.TypeNumber || args[1].Type() != js.TypeNumber {
+				return errors.New("unexpected number type")
+			}
+
+			err := app.Resize(args[0].Float(), args[1].Float())
+			if err != nil {
+				return fmt.Errorf("failed to resize: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
@@ -79,6 +79,11 @@ func marshalStepResponse(gameState domain.GameState, err error) map[string]inter
 		"error":       nil,
 		"gameObjects": marshalGameObjects(gameState.GameObjects),
 		"camera":      marshalCamera(gameState.Camera),
+		"parallax":    marshalParallaxLayers(gameState.Parallax),
+		"paused":      gameState.Paused,
+		"alpha":       gameState.Alpha,
+		"practice":    gameState.Practice,
+		"recordable":  gameState.Recordable,
 	}
 
 	if err != nil {
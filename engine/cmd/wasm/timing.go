@@ -0,0 +1,53 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+)
+
+// jsPause pauses the game.
+func jsPause(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		app.Pause()
+
+		return marshalErrorResponse(nil)
+	})
+}
+
+// jsResume resumes the game.
+func jsResume(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		app.Resume()
+
+		return marshalErrorResponse(nil)
+	})
+}
+
+// jsSetTimeScale sets the scale applied to the elapsed time of the game steps.
+func jsSetTimeScale(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		err := func() error {
+			if len(args) != 1 {
+				return errors.New("unexpected number of arguments in set time scale")
+			}
+
+			if args[0].Type() != js.TypeNumber {
+				return errors.New("unexpected number type")
+			}
+
+			err := app.SetTimeScale(args[0].Float())
+			if err != nil {
+				return fmt.Errorf("failed to set time scale: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalErrorResponse(err)
+	})
+}
@@ -0,0 +1,74 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// jsPredictJump predicts the arc of a jump of a player from its current position.
+func jsPredictJump(app *app.App) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var trajectory domain.Trajectory
+		err := func() error {
+			if len(args) != 3 {
+				return errors.New("unexpected number of arguments in predict jump")
+			}
+
+			if args[0].Type() != js.TypeNumber || args[1].Type() != js.TypeNumber {
+				return errors.New("unexpected number type")
+			}
+
+			if args[2].Type() != js.TypeString {
+				return errors.New("unexpected string type")
+			}
+
+			position, err := app.PlayerPosition(args[0].Int())
+			if err != nil {
+				return fmt.Errorf("failed to get player position: %w", err)
+			}
+
+			trajectory, err = app.PredictJump(position, args[1].Float(), args[2].String())
+			if err != nil {
+				return fmt.Errorf("failed to predict jump: %w", err)
+			}
+
+			return nil
+		}()
+
+		return marshalTrajectoryResponse(app, trajectory, err)
+	})
+}
+
+// marshalTrajectoryResponse serializes the trajectory response and returns a javascript object with the trajectory in
+// world and screen space.
+func marshalTrajectoryResponse(app *app.App, trajectory domain.Trajectory, err error) map[string]interface{} {
+	screenPoints := make([]vector2.Vector2, len(trajectory.Points))
+	for i, point := range trajectory.Points {
+		screenPoints[i] = app.WorldToScreen(point)
+	}
+
+	response := map[string]interface{}{
+		"error":         nil,
+		"points":        marshalVectors2(trajectory.Points),
+		"landing":       marshalVector2(trajectory.Landing),
+		"screenPoints":  marshalVectors2(screenPoints),
+		"screenLanding": marshalVector2(app.WorldToScreen(trajectory.Landing)),
+		"landed":        trajectory.Landed,
+		"ticks":         trajectory.Ticks,
+		"knockBacks":    trajectory.KnockBacks,
+	}
+
+	if err != nil {
+		response["error"] = err.Error()
+	}
+
+	return response
+}
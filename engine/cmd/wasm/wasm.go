@@ -7,7 +7,9 @@ import (
 	"github.com/goofr-group/go-math/vector2"
 	"github.com/goofr-group/physics-engine/pkg/game"
 
+	"github.com/goofr-group/jump-master/engine/internal/domain"
 	"github.com/goofr-group/jump-master/engine/internal/game/property"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
 )
 
 func marshalVector2(v vector2.Vector2) map[string]interface{} {
@@ -53,7 +55,7 @@ func marshalRigidBody(rigidBody *game.RigidBody2D) map[string]interface{} {
 	}
 }
 
-func marshalRenderer(renderer *game.Renderer, image interface{}, flipHorizontally interface{}) map[string]interface{} {
+func marshalRenderer(renderer *game.Renderer, image interface{}, flipHorizontally interface{}, opacity interface{}) map[string]interface{} {
 	if renderer == nil {
 		return nil
 	}
@@ -65,6 +67,7 @@ func marshalRenderer(renderer *game.Renderer, image interface{}, flipHorizontall
 		"layer":            renderer.Layer,
 		"image":            image,
 		"flipHorizontally": flipHorizontally,
+		"opacity":          opacity,
 	}
 }
 
@@ -107,21 +110,28 @@ func marshalCollider(collider *game.Collider2D) map[string]interface{} {
 	return response
 }
 
-func marshalSounds(sounds interface{}) []interface{} {
-	soundsString, ok := sounds.([]string)
-	response := make([]interface{}, 0, len(soundsString))
+func marshalStrings(values interface{}) []interface{} {
+	valuesString, ok := values.([]string)
+	response := make([]interface{}, 0, len(valuesString))
 
 	if ok {
-		for _, sound := range soundsString {
-			response = append(response, sound)
+		for _, value := range valuesString {
+			response = append(response, value)
 		}
 	}
 
 	return response
 }
 
-func marshalGameObject(gameObject game.Object) map[string]interface{} {
+func marshalDebug(gameObject game.Object) map[string]interface{} {
 	return map[string]interface{}{
+		"gravityRegion":    gameObject.Property(property.GravityRegion),
+		"terminalVelocity": gameObject.Property(property.TerminalVelocity),
+	}
+}
+
+func marshalGameObject(gameObject game.Object) map[string]interface{} {
+	response := map[string]interface{}{
 		"id": gameObject.ID(),
 
 		"active": gameObject.Active,
@@ -129,10 +139,18 @@ func marshalGameObject(gameObject game.Object) map[string]interface{} {
 
 		"transform": marshalTransform(gameObject.Transform),
 		"rigidBody": marshalRigidBody(gameObject.RigidBody),
-		"renderer":  marshalRenderer(gameObject.Renderer, gameObject.Property(property.Image), gameObject.Property(property.FlipHorizontally)),
+		"renderer":  marshalRenderer(gameObject.Renderer, gameObject.Property(property.Image), gameObject.Property(property.FlipHorizontally), gameObject.Property(property.Opacity)),
 		"collider":  marshalCollider(gameObject.Collider),
-		"sounds":    marshalSounds(gameObject.Property(property.Sounds)),
+		"sounds":    marshalStrings(gameObject.Property(property.Sounds)),
+		"events":    marshalStrings(gameObject.Property(property.Events)),
+	}
+
+	// Only the players have debug properties, so they are not serialized for every tile.
+	if gameObject.Tag == tag.Player {
+		response["debug"] = marshalDebug(gameObject)
 	}
+
+	return response
 }
 
 func marshalGameObjects(gameObjects []game.Object) []interface{} {
@@ -144,6 +162,21 @@ func marshalGameObjects(gameObjects []game.Object) []interface{} {
 	return objects
 }
 
+func marshalParallaxLayers(layers []domain.ParallaxLayer) []interface{} {
+	response := make([]interface{}, len(layers))
+	for i, layer := range layers {
+		response[i] = map[string]interface{}{
+			"image":    layer.Image,
+			"position": marshalVector2(layer.Position),
+			"size":     marshalVector2(layer.Size),
+			"repeat":   layer.Repeat,
+			"order":    layer.Order,
+		}
+	}
+
+	return response
+}
+
 func marshalCamera(camera rendering.Camera) map[string]interface{} {
 	return map[string]interface{}{
 		"position": marshalVector2(camera.Position),
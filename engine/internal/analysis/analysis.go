@@ -0,0 +1,349 @@
+// Package analysis finds which surfaces of a map can be reached by the player, by simulating the possible jumps from
+// each surface with the physics of the game.
+package analysis
+
+import (
+	"math"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+)
+
+// standOffset defines the distance, in world units, above a surface where the player is placed before jumping, so
+// that it does not start overlapping the surface.
+const standOffset = 1
+
+// directions defines the directions held when the simulated jumps are released.
+var directions = []string{"", action.Left, action.Right}
+
+// Options defines the structure of the analysis options.
+type Options struct {
+	ChargeLevels int // Defines the number of jump charges simulated, evenly distributed from no charge to the full charge.
+	Samples      int // Defines the number of positions on each surface the jumps are simulated from: 1 for the center, 2 for both ends and 3 or more for both ends and the center.
+}
+
+// Surface defines a horizontal run of tiles the player can stand on.
+type Surface struct {
+	ID    int     `json:"id"`
+	X     int     `json:"x"`     // Defines the position on the x-axis of the leftmost tile on the map.
+	Y     int     `json:"y"`     // Defines the position on the y-axis of the tiles on the map.
+	Width int     `json:"width"` // Defines the width of the surface in tiles.
+	Left  float64 `json:"left"`  // Defines the left bound of the surface in the game world.
+	Right float64 `json:"right"` // Defines the right bound of the surface in the game world.
+	Top   float64 `json:"top"`   // Defines the height of the surface in the game world.
+}
+
+// Edge defines a jump from a surface that lands on another surface.
+type Edge struct {
+	From       int               `json:"from"`
+	To         int               `json:"to"`
+	Start      vector2.Vector2   `json:"start"`     // Defines the position of the player before the jump.
+	Charge     float64           `json:"charge"`    // Defines the jump charge, in the range [0; 1].
+	Direction  string            `json:"direction"` // Defines the direction held when the jump is released, or empty for a vertical jump.
+	Ticks      int               `json:"ticks"`     // Defines the number of physics updates until the player lands.
+	KnockBacks int               `json:"knockBacks"`
+	Points     []vector2.Vector2 `json:"points,omitempty"` // Defines the positions of the player during the jump. Only kept for the jumps of the goal path.
+}
+
+// Report defines the result of the analysis.
+type Report struct {
+	Start       int       `json:"start"` // Defines the surface where the player spawns.
+	Goal        int       `json:"goal"`  // Defines the highest surface of the map.
+	Surfaces    []Surface `json:"surfaces"`
+	Edges       []Edge    `json:"edges"`       // Defines the jumps between different surfaces, without duplicates.
+	Unreachable []int     `json:"unreachable"` // Defines the surfaces that cannot be reached from the start.
+	DeadEnds    []int     `json:"deadEnds"`    // Defines the reachable surfaces from which the goal cannot be reached.
+	GoalPath    []Edge    `json:"goalPath"`    // Defines the jumps with the fewest steps from the start to the goal, or empty if the goal cannot be reached.
+}
+
+// Analyze finds the surfaces of the given map the player can stand on, simulates the jumps from each one with the given
+// configurations and returns the reachability report. A surface is the goal if it is the highest of the map.
+func Analyze(engineConfig config.Engine, playerConfig config.Player, mapConfig config.Map, options Options) Report {
+	report := Report{
+		Start:    -1,
+		Goal:     -1,
+		Surfaces: Surfaces(mapConfig, playerConfig),
+	}
+
+	// Find the start and goal surfaces.
+	spawn := playerConfig.Object.Position
+	if len(engineConfig.Players) > 0 {
+		spawn = engineConfig.Players[0].Position
+	}
+
+	spawnBottom := spawn.Y + playerConfig.Object.ColliderOffset.Y
+	for _, surface := range report.Surfaces {
+		if overlaps(surface, spawn.X, playerConfig) && surface.Top <= spawnBottom+standOffset &&
+			(report.Start < 0 || surface.Top > report.Surfaces[report.Start].Top) {
+			report.Start = surface.ID
+		}
+
+		if report.Goal < 0 || surface.Top > report.Surfaces[report.Goal].Top {
+			report.Goal = surface.ID
+		}
+	}
+
+	// Simulate the jumps from each surface.
+	engineConfig.Players = nil
+	a := app.New(engineConfig, playerConfig, mapConfig)
+
+	type edgeKey struct {
+		from, to int
+	}
+	edges := make(map[edgeKey]int)
+
+	for _, surface := range report.Surfaces {
+		for _, x := range samples(surface, mapConfig, options.Samples) {
+			start := vector2.Vector2{
+				X: x,
+				Y: surface.Top - playerConfig.Object.ColliderOffset.Y + standOffset,
+			}
+
+			for _, charge := range charges(options.ChargeLevels) {
+				for _, direction := range directions {
+					trajectory, err := a.PredictJump(start, charge, direction)
+					if err != nil || !trajectory.Landed {
+						continue
+					}
+
+					to := landingSurface(report.Surfaces, trajectory.Landing, playerConfig, mapConfig)
+					if to < 0 || to == surface.ID {
+						continue
+					}
+
+					// Keep the fastest jump between each pair of surfaces.
+					edge := Edge{
+						From:       surface.ID,
+						To:         to,
+						Start:      start,
+						Charge:     charge,
+						Direction:  direction,
+						Ticks:      trajectory.Ticks,
+						KnockBacks: trajectory.KnockBacks,
+						Points:     trajectory.Points,
+					}
+
+					key := edgeKey{from: surface.ID, to: to}
+					if i, ok := edges[key]; ok {
+						if edge.Ticks < report.Edges[i].Ticks {
+							report.Edges[i] = edge
+						}
+						continue
+					}
+
+					edges[key] = len(report.Edges)
+					report.Edges = append(report.Edges, edge)
+				}
+			}
+		}
+	}
+
+	report.reachability()
+
+	return report
+}
+
+// Surfaces returns the horizontal runs of tiles of the given map the player can stand on, which are the colliding
+// tiles with enough empty space above them for the player collider.
+func Surfaces(m config.Map, playerConfig config.Player) []Surface {
+	if m.TileSize <= 0 {
+		return nil
+	}
+
+	// Get the colliding tiles.
+	solid := make(map[[2]int]bool)
+	for _, layer := range m.Layers {
+		if !layer.Collider {
+			continue
+		}
+
+		for _, tile := range layer.Tiles {
+			solid[[2]int{tile.X, tile.Y}] = true
+		}
+	}
+
+	// Compute the number of tiles above a surface that must be empty.
+	clearance := int(math.Ceil(playerConfig.Object.ColliderSize.Y / float64(m.TileSize)))
+
+	standable := func(x, y int) bool {
+		if !solid[[2]int{x, y}] {
+			return false
+		}
+
+		for i := 1; i <= clearance; i++ {
+			if y-i >= 0 && solid[[2]int{x, y - i}] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	var surfaces []Surface
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			if !standable(x, y) {
+				continue
+			}
+
+			// Extend the surface to the right.
+			width := 1
+			for x+width < m.Width && standable(x+width, y) {
+				width++
+			}
+
+			bounds := grid.AreaRect(m, x, y, width, 1)
+			surfaces = append(surfaces, Surface{
+				ID:    len(surfaces),
+				X:     x,
+				Y:     y,
+				Width: width,
+				Left:  bounds.Min.X,
+				Right: bounds.Max.X,
+				Top:   bounds.Max.Y,
+			})
+
+			x += width - 1
+		}
+	}
+
+	return surfaces
+}
+
+// reachability computes the unreachable surfaces, the dead ends and the goal path from the edges of the report.
+func (r *Report) reachability() {
+	forward := make(map[int][]int)
+	backward := make(map[int][]int)
+	for i, edge := range r.Edges {
+		forward[edge.From] = append(forward[edge.From], i)
+		backward[edge.To] = append(backward[edge.To], i)
+	}
+
+	// Find the surfaces reachable from the start, keeping the jump used to reach each one first.
+	reached := make(map[int]int)
+	if r.Start >= 0 {
+		reached[r.Start] = -1
+		queue := []int{r.Start}
+		for len(queue) > 0 {
+			from := queue[0]
+			queue = queue[1:]
+
+			for _, i := range forward[from] {
+				to := r.Edges[i].To
+				if _, ok := reached[to]; !ok {
+					reached[to] = i
+					queue = append(queue, to)
+				}
+			}
+		}
+	}
+
+	// Find the surfaces from which the goal can be reached.
+	reachesGoal := make(map[int]bool)
+	if r.Goal >= 0 {
+		reachesGoal[r.Goal] = true
+		queue := []int{r.Goal}
+		for len(queue) > 0 {
+			to := queue[0]
+			queue = queue[1:]
+
+			for _, i := range backward[to] {
+				from := r.Edges[i].From
+				if !reachesGoal[from] {
+					reachesGoal[from] = true
+					queue = append(queue, from)
+				}
+			}
+		}
+	}
+
+	r.Unreachable = []int{}
+	r.DeadEnds = []int{}
+	for _, surface := range r.Surfaces {
+		if _, ok := reached[surface.ID]; !ok {
+			r.Unreachable = append(r.Unreachable, surface.ID)
+		} else if !reachesGoal[surface.ID] {
+			r.DeadEnds = append(r.DeadEnds, surface.ID)
+		}
+	}
+
+	// Follow the jumps back from the goal to the start.
+	r.GoalPath = []Edge{}
+	pathEdges := make(map[int]bool)
+	if i, ok := reached[r.Goal]; ok {
+		for ; i >= 0; i = reached[r.Edges[i].From] {
+			pathEdges[i] = true
+			r.GoalPath = append([]Edge{r.Edges[i]}, r.GoalPath...)
+		}
+	}
+
+	// Only keep the positions of the jumps of the goal path.
+	for i := range r.Edges {
+		if !pathEdges[i] {
+			r.Edges[i].Points = nil
+		}
+	}
+}
+
+// samples returns the positions on the x-axis of the given surface the jumps are simulated from.
+func samples(surface Surface, m config.Map, n int) []float64 {
+	tileSize := float64(m.TileSize)
+	left := surface.Left + tileSize/2
+	right := surface.Right - tileSize/2
+	center := grid.TilePosition(m, surface.X+surface.Width/2, surface.Y).X
+
+	switch {
+	case n <= 1 || surface.Width == 1:
+		return []float64{center}
+	case n == 2 || surface.Width == 2:
+		return []float64{left, right}
+	default:
+		return []float64{left, center, right}
+	}
+}
+
+// charges returns the jump charges simulated for the given number of charge levels.
+func charges(levels int) []float64 {
+	if levels <= 1 {
+		return []float64{1}
+	}
+
+	values := make([]float64, levels)
+	for i := range values {
+		values[i] = float64(i) / float64(levels-1)
+	}
+
+	return values
+}
+
+// landingSurface returns the identifier of the surface the player lands on in the given position, or -1 if none.
+func landingSurface(surfaces []Surface, landing vector2.Vector2, playerConfig config.Player, m config.Map) int {
+	bottom := landing.Y + playerConfig.Object.ColliderOffset.Y
+	tolerance := float64(m.TileSize) / 2
+
+	found := -1
+	for _, surface := range surfaces {
+		distance := math.Abs(surface.Top - bottom)
+		if distance > tolerance || !overlaps(surface, landing.X, playerConfig) {
+			continue
+		}
+
+		if found < 0 || distance < math.Abs(surfaces[found].Top-bottom) {
+			found = surface.ID
+		}
+	}
+
+	return found
+}
+
+// overlaps returns true if the player collider in the given position on the x-axis overlaps the given surface.
+func overlaps(surface Surface, x float64, playerConfig config.Player) bool {
+	left := x + playerConfig.Object.ColliderOffset.X
+	right := left + playerConfig.Object.ColliderSize.X
+
+	return left < surface.Right && right > surface.Left
+}
@@ -0,0 +1,133 @@
+package analysis
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+)
+
+// Colors of the overlay image.
+var (
+	colorBackground  = color.RGBA{R: 24, G: 24, B: 32, A: 255}
+	colorTile        = color.RGBA{R: 96, G: 96, B: 104, A: 255}
+	colorReachable   = color.RGBA{R: 64, G: 200, B: 96, A: 255}  // Surfaces from which the goal can be reached.
+	colorDeadEnd     = color.RGBA{R: 240, G: 160, B: 32, A: 255} // Reachable surfaces from which the goal cannot be reached.
+	colorUnreachable = color.RGBA{R: 224, G: 48, B: 48, A: 255}  // Surfaces that cannot be reached from the start.
+	colorPath        = color.RGBA{R: 64, G: 160, B: 255, A: 255} // Jumps of the goal path.
+)
+
+// surfaceThickness defines the thickness, in pixels, of the surface lines of the overlay image.
+const surfaceThickness = 3
+
+// Overlay returns an image of the given map with the surfaces colored by their reachability and the jumps of the goal
+// path, where each world unit is drawn with the given number of pixels.
+func Overlay(m config.Map, report Report, scale float64) *image.RGBA {
+	bounds := grid.MapRect(m)
+	width := int(math.Ceil((bounds.Max.X - bounds.Min.X) * scale))
+	height := int(math.Ceil((bounds.Max.Y - bounds.Min.Y) * scale))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	// toImage converts a position in world space to the image space, where the y-axis points downwards.
+	toImage := func(position vector2.Vector2) (int, int) {
+		return int((position.X - bounds.Min.X) * scale), int((bounds.Max.Y - position.Y) * scale)
+	}
+
+	fill(img, img.Bounds(), colorBackground)
+
+	// Draw the colliding tiles.
+	for _, layer := range m.Layers {
+		if !layer.Collider {
+			continue
+		}
+
+		for _, tile := range layer.Tiles {
+			rect := grid.TileRect(m, tile.X, tile.Y)
+			x0, y0 := toImage(vector2.Vector2{X: rect.Min.X, Y: rect.Max.Y})
+			x1, y1 := toImage(vector2.Vector2{X: rect.Max.X, Y: rect.Min.Y})
+			fill(img, image.Rect(x0, y0, x1, y1), colorTile)
+		}
+	}
+
+	// Draw the surfaces colored by their reachability.
+	status := make(map[int]color.RGBA)
+	for _, id := range report.DeadEnds {
+		status[id] = colorDeadEnd
+	}
+	for _, id := range report.Unreachable {
+		status[id] = colorUnreachable
+	}
+
+	for _, surface := range report.Surfaces {
+		c, ok := status[surface.ID]
+		if !ok {
+			c = colorReachable
+		}
+
+		x0, y := toImage(vector2.Vector2{X: surface.Left, Y: surface.Top})
+		x1, _ := toImage(vector2.Vector2{X: surface.Right, Y: surface.Top})
+		fill(img, image.Rect(x0, y, x1, y+surfaceThickness), c)
+	}
+
+	// Draw the jumps of the goal path.
+	for _, edge := range report.GoalPath {
+		previous := edge.Start
+		for _, point := range edge.Points {
+			x0, y0 := toImage(previous)
+			x1, y1 := toImage(point)
+			line(img, x0, y0, x1, y1, colorPath)
+			previous = point
+		}
+	}
+
+	return img
+}
+
+// fill draws the given rectangle of the image with the given color.
+func fill(img *image.RGBA, rect image.Rectangle, c color.RGBA) {
+	rect = rect.Canon().Intersect(img.Bounds())
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// line draws a line between the given points of the image with the given color.
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx := int(math.Abs(float64(x1 - x0)))
+	dy := -int(math.Abs(float64(y1 - y0)))
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	// Bresenham's line algorithm.
+	e := dx + dy
+	for {
+		if (image.Point{X: x0, Y: y0}).In(img.Bounds()) {
+			img.SetRGBA(x0, y0, c)
+		}
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
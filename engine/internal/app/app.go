@@ -1,7 +1,9 @@
 package app
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/goofr-group/game-engine/pkg/rendering"
@@ -12,13 +14,32 @@ import (
 	"github.com/goofr-group/jump-master/engine/internal/config"
 	"github.com/goofr-group/jump-master/engine/internal/domain"
 	"github.com/goofr-group/jump-master/engine/internal/game"
+	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
 	"github.com/goofr-group/jump-master/engine/internal/game/prefab"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
 )
 
 // App defines the main application structure.
 type App struct {
-	gameEngine game.Engine // Represents the game engine being used.
-	lastStep   time.Time   // Represents the time when the last step occurred.
+	gameEngine    game.Engine     // Represents the game engine being used.
+	timing        timing          // Represents the controller of the time step of the game steps.
+	input         input           // Represents the state of the actions between the game steps.
+	practice      practice        // Represents the state of the practice mode.
+	run           run             // Represents the state of the current run.
+	output        output          // Represents the sounds and events of the players since the last game state.
+	playerObjects []*core.Object  // Represents the player objects in the game world.
+	players       []prefab.Player // Represents the player prefabs in the game world.
+	ghosts        []prefab.Ghost  // Represents the ghost prefabs in the game world.
+	sandbox       *App            // Represents the separate game world used to predict the jumps, created on the first prediction.
+
+	opponents map[int]*core.Object // Represents the objects of the race opponents by identifier.
+
+	tiles map[prefab.TileKey]*core.Object // Represents the map objects by tile position.
+
+	runTimer         *behaviour.RunTimer         // Represents the timer of the current run.
+	cameraController *behaviour.CameraController // Represents the controller of the camera position.
 
 	engineConfig config.Engine // Represents the engine configuration.
 	playerConfig config.Player // Represents the player configuration.
@@ -34,6 +55,9 @@ func New(engineConfig config.Engine, playerConfig config.Player, mapConfig confi
 
 	return &App{
 		gameEngine:   game.NewEngine(camera),
+		timing:       newTiming(engineConfig.Physics.MaxTimeStep),
+		input:        newInput(),
+		output:       newOutput(),
 		engineConfig: engineConfig,
 		playerConfig: playerConfig,
 		mapConfig:    mapConfig,
@@ -48,54 +72,191 @@ func (a *App) StartGameWorld() error {
 	physicsConfig := a.engineConfig.Physics
 
 	// Set up physics configurations.
-	a.lastStep = time.Now()
+	a.timing.lastStep = time.Now()
 	gameEngine.SetFixedDeltaTime(physicsConfig.UpdateRate)
 	physicsEngine.SetGravity(physicsConfig.Gravity)
 	physicsEngine.CollisionSolvingIterations = 50
 
-	// Create the camera controller object.
-	err := prefab.NewCameraController(a.gameEngine, a.engineConfig.Camera)
+	// Get the players in the game. If not defined, a single player is spawned in the position of the player
+	// configuration.
+	slots := a.engineConfig.Players
+	if len(slots) == 0 {
+		slots = []config.PlayerSlot{{Position: a.playerConfig.Object.Position}}
+	}
+
+	// Create the player objects.
+	players := make([]prefab.Player, 0, len(slots))
+	playerObjects := make([]*core.Object, 0, len(slots))
+	for _, slot := range slots {
+		player, err := prefab.NewPlayer(a.gameEngine, a.playerConfig, slot, a.engineConfig, a.mapConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create player prefab: %w", err)
+		}
+
+		players = append(players, player)
+		playerObjects = append(playerObjects, player.Object)
+	}
+
+	a.players = players
+	a.playerObjects = playerObjects
+
+	// Ignore the collisions between the players, unless configured otherwise.
+	if !a.engineConfig.PlayerCollision && len(a.engineConfig.Players) > 1 {
+		prefab.IgnorePlayerCollisions(a.gameEngine, slots)
+	}
+
+	// Create the run timer object.
+	runTimer, err := prefab.NewRunTimer(a.gameEngine)
 	if err != nil {
-		return fmt.Errorf("failed to create camera controller prefab: %w", err)
+		return fmt.Errorf("failed to create run timer prefab: %w", err)
 	}
 
-	// Create the player object.
-	err = prefab.NewPlayer(a.gameEngine, a.playerConfig)
+	a.runTimer = runTimer
+
+	// Create the camera controller object.
+	cameraController, err := prefab.NewCameraController(a.gameEngine, a.engineConfig.Camera, a.mapConfig, playerObjects)
 	if err != nil {
-		return fmt.Errorf("failed to create player prefab: %w", err)
+		return fmt.Errorf("failed to create camera controller prefab: %w", err)
 	}
 
+	a.cameraController = cameraController
+
 	// Create the map objects (platforms and props).
-	err = prefab.NewMap(a.gameEngine, a.mapConfig, a.engineConfig.TileSprites)
+	tiles, err := prefab.NewMap(a.gameEngine, a.mapConfig, a.engineConfig.TileSprites)
 	if err != nil {
 		return fmt.Errorf("failed to create map objects prefab: %w", err)
 	}
 
+	a.tiles = tiles
+
 	return nil
 }
 
-// GameStep performs an engine step and returns the current state of every game object in the world.
-func (a *App) GameStep(actions map[string]bool) (domain.GameState, error) {
-	// Update the state of the actions.
-	for action, state := range actions {
-		a.gameEngine.ActionManager().SetAction(action, state)
+// LoadGhosts replaces the ghost runs rendered alongside the players with the given traces. The ghosts are aligned to
+// the elapsed time of the current run.
+func (a *App) LoadGhosts(traces []replay.Trace) error {
+	// Check if the game world has started.
+	if a.runTimer == nil {
+		return errors.New("game world not started")
+	}
+
+	// Remove the previous ghosts from the game world.
+	for _, ghost := range a.ghosts {
+		if err := a.gameEngine.DestroyGameObject(ghost.Object.ID()); err != nil {
+			return fmt.Errorf("failed to remove ghost object: %w", err)
+		}
 	}
+	a.ghosts = nil
+
+	// Create the ghost objects.
+	ghosts := make([]prefab.Ghost, 0, len(traces))
+	for _, trace := range traces {
+		ghost, err := prefab.NewGhost(a.gameEngine, trace, a.runTimer, a.playerConfig, a.engineConfig.Ghost)
+		if err != nil {
+			return fmt.Errorf("failed to create ghost prefab: %w", err)
+		}
 
+		ghosts = append(ghosts, ghost)
+	}
+
+	a.ghosts = ghosts
+
+	return nil
+}
+
+// GameStep performs an engine step with the time elapsed since the last step and returns the current state of every
+// game object in the world. The time step is controlled by the timing controller, and the game world does not advance
+// while paused.
+func (a *App) GameStep(actions map[string]bool) (domain.GameState, error) {
 	// Get the current time step.
-	timeStep := time.Since(a.lastStep).Seconds()
-	a.lastStep = time.Now()
+	timeStep := a.timing.timeStep(time.Now())
 
 	// Perform the actual game step.
-	if err := a.gameEngine.Engine().Step(timeStep); err != nil {
-		return domain.GameState{}, fmt.Errorf("failed to perform the game step: %w", err)
+	if !a.timing.paused {
+		if err := a.Step(actions, timeStep); err != nil {
+			return domain.GameState{}, err
+		}
 	}
 
-	// Get the game camera.
-	camera := a.gameEngine.Camera()
+	return a.GameState(), nil
+}
+
+// Step updates the state of the actions and advances the game world by the given time step in seconds. The time step
+// is accumulated, and the game world advances in physics updates of the update rate, each followed by an update pass,
+// so that the game world advances identically at any display rate. Stepping with the physics update rate performs
+// exactly one physics update.
+func (a *App) Step(actions map[string]bool, timeStep float64) error {
+	// Update the state of the actions.
+	a.input.update(actions)
+
+	gameEngine := a.gameEngine.Engine()
+	updateRate := a.UpdateRate()
+	if updateRate <= 0 {
+		return errors.New("invalid physics update rate")
+	}
+
+	// Perform a physics update for every update rate in the accumulated time. The tolerance prevents the rounding
+	// errors of the accumulated time steps from dropping a physics update.
+	a.timing.accumulator += timeStep
+	for a.timing.accumulator >= updateRate-accumulatorTolerance {
+		actions := a.input.tick()
+		for action, state := range actions {
+			a.gameEngine.ActionManager().SetAction(action, state)
+		}
+
+		// Perform the actual game step.
+		if err := gameEngine.Step(updateRate); err != nil {
+			return fmt.Errorf("failed to perform the game step: %w", err)
+		}
+
+		// Collect the sounds and events of the physics update until they are returned in a game state.
+		a.output.collect(a.playerObjects)
+
+		// Record the physics update in the current run.
+		a.recordTick(actions)
+
+		a.timing.accumulator = math.Max(a.timing.accumulator-updateRate, 0)
+	}
+
+	return nil
+}
+
+// GameState returns the current state of every game object visible by the camera, in screen space. The players have
+// the sounds and events of every physics update since the last game state, so that each of them is returned once.
+func (a *App) GameState() domain.GameState {
+	// Get the fraction of the next physics update that has elapsed, used to interpolate the camera and the moving
+	// objects, so that they move smoothly at any display rate.
+	alpha := a.timing.alpha(a.UpdateRate())
+	camera := a.camera(alpha)
+
+	// Get the interpolated positions of the players and ghosts.
+	positions := make(map[int64]vector2.Vector2, len(a.players)+len(a.ghosts))
+	for _, player := range a.players {
+		positions[player.Object.ID()] = player.Interpolator.Position(alpha)
+	}
+	for _, ghost := range a.ghosts {
+		positions[ghost.Object.ID()] = ghost.Interpolator.Position(alpha)
+	}
 
 	// Get game objects to render.
 	var gameObjects []core.Object
 	for _, object := range a.gameEngine.Engine().GetState() {
+		// Ignore objects that were removed from the game world.
+		if !object.Active {
+			continue
+		}
+
+		// Render the object in its interpolated position.
+		if position, ok := positions[object.ID()]; ok {
+			object.Transform.Position = position
+		}
+
+		// Set the sounds and events collected since the last game state.
+		if object.Tag == tag.Player {
+			object.SetProperty(property.Sounds, a.output.sounds[object.ID()])
+			object.SetProperty(property.Events, a.output.events[object.ID()])
+		}
+
 		// Ignore objects that are not supposed to be visible.
 		if !camera.IsVisible(object) {
 			continue
@@ -147,8 +308,105 @@ func (a *App) GameStep(actions map[string]bool) (domain.GameState, error) {
 		gameObjects = append(gameObjects, object)
 	}
 
+	// The collected sounds and events were returned, so they are not played again in the next game state.
+	a.output.clear()
+
 	return domain.GameState{
 		GameObjects: gameObjects,
-		Camera:      *camera,
-	}, nil
+		Camera:      camera,
+		Parallax:    parallaxLayers(a.engineConfig.Parallax, camera),
+		Paused:      a.timing.paused,
+		Alpha:       alpha,
+		Practice:    a.practice.enabled,
+		Recordable:  a.Recordable(),
+	}
+}
+
+// camera returns a copy of the game camera in its position interpolated with the given alpha, with the camera effects
+// applied, so that they only affect the rendered state.
+func (a *App) camera(alpha float64) rendering.Camera {
+	camera := *a.gameEngine.Camera()
+
+	// Apply the interpolated position and the camera shake offset.
+	if a.cameraController != nil {
+		camera.Position = a.cameraController.Position(alpha)
+
+		shakeOffset := a.cameraController.ShakeOffset()
+		camera.Position.X += shakeOffset.X
+		camera.Position.Y += shakeOffset.Y
+	}
+
+	return camera
+}
+
+// Players returns the current state of every player in the game world, in world space.
+func (a *App) Players() []domain.PlayerState {
+	players := make([]domain.PlayerState, 0, len(a.playerObjects))
+	for _, object := range a.playerObjects {
+		player := domain.PlayerState{
+			Position: object.Transform.Position,
+		}
+
+		if object.RigidBody != nil {
+			player.Velocity = object.RigidBody.Velocity
+		}
+		if image, ok := object.Property(property.Image).(string); ok {
+			player.Image = image
+		}
+		if flipHorizontally, ok := object.Property(property.FlipHorizontally).(bool); ok {
+			player.FlipHorizontally = flipHorizontally
+		}
+		if events, ok := object.Property(property.Events).([]string); ok {
+			player.Events = events
+		}
+
+		players = append(players, player)
+	}
+
+	return players
+}
+
+// Debug returns the internal state of the behaviours and physics of the game world.
+func (a *App) Debug() domain.DebugState {
+	var state domain.DebugState
+
+	for _, player := range a.players {
+		state.Players = append(state.Players, player.Debugger.Debug())
+	}
+
+	if a.cameraController != nil {
+		state.Camera = a.cameraController.Debug()
+	}
+
+	return state
+}
+
+// SetCameraShake enables or disables the camera shake, for accessibility.
+func (a *App) SetCameraShake(enabled bool) {
+	if a.cameraController != nil {
+		a.cameraController.SetShakeEnabled(enabled)
+	}
+}
+
+// Level returns the highest screen level reached by the players in the current state of the game world.
+func (a *App) Level() int {
+	screenSize := behaviour.ScreenSize(a.engineConfig.Camera)
+
+	var level int
+	for _, object := range a.playerObjects {
+		// Check if the collider is accessible.
+		if object.Collider == nil {
+			continue
+		}
+
+		playerLevel := behaviour.ScreenLevel(object.Collider.Bounds().Min.Y, a.engineConfig.Camera.Position.Y, screenSize.Y)
+		level = max(level, playerLevel)
+	}
+
+	return level
+}
+
+// UpdateRate returns the physics update rate in seconds.
+func (a *App) UpdateRate() float64 {
+	return a.engineConfig.Physics.UpdateRate
 }
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/prefab"
+	"github.com/goofr-group/jump-master/engine/internal/spritefusion"
+)
+
+// SetTile places the tile with the given identifier in the given map coordinates of the layer with the given name,
+// replacing any tile already in that position. The tile object is created in the same way as the map objects.
+// Editing the map excludes the current run from the records.
+func (a *App) SetTile(layerName string, x, y int, id string) error {
+	layerIndex, err := a.editableTile(layerName, x, y)
+	if err != nil {
+		return err
+	}
+
+	// Remove the tile already in the position, if any.
+	if _, err = a.removeTile(layerIndex, x, y); err != nil {
+		return err
+	}
+
+	// Create the tile object.
+	tile := config.Tile{ID: id, X: x, Y: y}
+	layer := &a.mapConfig.Layers[layerIndex]
+
+	object, err := prefab.NewTile(a.gameEngine, a.mapConfig, *layer, tile, a.engineConfig.TileSprites)
+	if err != nil {
+		return fmt.Errorf("failed to create tile prefab: %w", err)
+	}
+
+	// Add the tile to the map configuration.
+	layer.Tiles = append(layer.Tiles, tile)
+	a.tiles[prefab.TileKey{Layer: layerName, X: x, Y: y}] = object
+	a.updateStaticTiles(*layer)
+	a.run.edited = true
+	a.sandbox = nil
+
+	return nil
+}
+
+// RemoveTile removes the tile in the given map coordinates of the layer with the given name, if any. Editing the map
+// excludes the current run from the records.
+func (a *App) RemoveTile(layerName string, x, y int) error {
+	layerIndex, err := a.editableTile(layerName, x, y)
+	if err != nil {
+		return err
+	}
+
+	removed, err := a.removeTile(layerIndex, x, y)
+	if err != nil {
+		return err
+	}
+
+	if removed {
+		a.updateStaticTiles(a.mapConfig.Layers[layerIndex])
+		a.run.edited = true
+		a.sandbox = nil
+	}
+
+	return nil
+}
+
+// Map returns the current map configuration, including the edited tiles.
+func (a *App) Map() config.Map {
+	m := a.mapConfig
+	m.Layers = slices.Clone(m.Layers)
+	for i := range m.Layers {
+		m.Layers[i].Tiles = slices.Clone(m.Layers[i].Tiles)
+	}
+
+	return m
+}
+
+// ExportSpriteFusion returns the current map configuration, including the edited tiles, in the Sprite Fusion project
+// format, based on the project of the game map.
+func (a *App) ExportSpriteFusion() (spritefusion.Project, error) {
+	base, err := spritefusion.Load()
+	if err != nil {
+		return spritefusion.Project{}, fmt.Errorf("failed to load sprite fusion project: %w", err)
+	}
+
+	project, err := spritefusion.Export(base, a.Map())
+	if err != nil {
+		return spritefusion.Project{}, fmt.Errorf("failed to export sprite fusion project: %w", err)
+	}
+
+	return project, nil
+}
+
+// editableTile returns the index of the layer with the given name, if the game world has started and the given map
+// coordinates are inside the map. The map cannot grow, since the game world positions of the tiles depend on its
+// height.
+func (a *App) editableTile(layerName string, x, y int) (int, error) {
+	if a.tiles == nil {
+		return 0, errors.New("game world not started")
+	}
+
+	layerIndex := slices.IndexFunc(a.mapConfig.Layers, func(layer config.Layer) bool {
+		return layer.Name == layerName
+	})
+	if layerIndex < 0 {
+		return 0, fmt.Errorf("layer %q not found", layerName)
+	}
+
+	if x < 0 || x >= a.mapConfig.Width || y < 0 || y >= a.mapConfig.Height {
+		return 0, fmt.Errorf("tile position (%d, %d) outside the map", x, y)
+	}
+
+	// Copy the tiles of the layer before editing them, since they may be shared with the given configuration.
+	a.mapConfig.Layers = slices.Clone(a.mapConfig.Layers)
+	a.mapConfig.Layers[layerIndex].Tiles = slices.Clone(a.mapConfig.Layers[layerIndex].Tiles)
+
+	return layerIndex, nil
+}
+
+// removeTile removes the tiles in the given map coordinates of the layer with the given index from the map
+// configuration, and removes their object from the game world. Returns true if a tile was removed.
+func (a *App) removeTile(layerIndex, x, y int) (bool, error) {
+	layer := &a.mapConfig.Layers[layerIndex]
+
+	count := len(layer.Tiles)
+	layer.Tiles = slices.DeleteFunc(layer.Tiles, func(tile config.Tile) bool {
+		return tile.X == x && tile.Y == y
+	})
+	removed := len(layer.Tiles) != count
+
+	key := prefab.TileKey{Layer: layer.Name, X: x, Y: y}
+	if object, ok := a.tiles[key]; ok {
+		if err := a.gameEngine.DestroyGameObject(object.ID()); err != nil {
+			return removed, fmt.Errorf("failed to remove tile object: %w", err)
+		}
+
+		// Discard the contacts of the players with the removed tile, so that they do not stand on it anymore.
+		for _, player := range a.players {
+			player.Contacts.Remove(object.ID())
+		}
+
+		delete(a.tiles, key)
+		removed = true
+	}
+
+	return removed, nil
+}
+
+// updateStaticTiles updates the static tiles swept by the players, if the given edited layer collides with them.
+func (a *App) updateStaticTiles(layer config.Layer) {
+	if !layer.Collider {
+		return
+	}
+
+	tiles := prefab.StaticTiles(a.mapConfig)
+	for _, player := range a.players {
+		if player.Sweep != nil {
+			player.Sweep.SetTiles(tiles)
+		}
+	}
+}
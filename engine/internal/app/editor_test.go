@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestEditTileDropThrough(t *testing.T) {
+	// Draw an empty map with a floor at the bottom, and spawn the player at the top of the map above the edited tile.
+	s := gametest.NewScenario(t, gametest.Floor(20, "#####")...)
+
+	const x, y = 2, 10
+	s.Player.Object.Position = s.Drop(x)
+
+	w := gametest.New(t, s.Options()...)
+	a := w.App()
+
+	// Place, remove and replace the tile, so that the player lands on it.
+	for _, edit := range []func() error{
+		func() error { return a.SetTile(tag.Platform, x, y, "") },
+		func() error { return a.RemoveTile(tag.Platform, x, y) },
+		func() error { return a.SetTile(tag.Platform, x, y, "") },
+	} {
+		if err := edit(); err != nil {
+			t.Fatalf("failed to edit the tile: %v", err)
+		}
+	}
+
+	w.Wait(w.Seconds(3))
+	w.AssertGrounded()
+	w.AssertPositionNear(s.Standing(x, y), 1)
+
+	// Remove the tile, so that the player drops through its position onto the floor.
+	if err := a.RemoveTile(tag.Platform, x, y); err != nil {
+		t.Fatalf("failed to remove the tile: %v", err)
+	}
+
+	// The player does not stand on the removed tile, so it falls and cannot charge a jump.
+	w.Hold(2, action.Jump)
+	w.AssertAirborne()
+	if velocity := w.Player().Velocity; velocity.Y >= 0 {
+		t.Errorf("expected the player to fall, got velocity %v", velocity)
+	}
+	if player, _ := w.Debug(); player.Jump.Charge != 0 {
+		t.Errorf("expected no jump charge in the air, got %v", player.Jump.Charge)
+	}
+
+	w.Wait(w.Seconds(3))
+	w.AssertGrounded()
+	w.AssertPositionNear(s.Standing(x, s.Map.Height-1), 1)
+
+	if tiles := a.Map().Layers[0].Tiles; len(tiles) != s.Map.Width {
+		t.Errorf("expected only the floor tiles in the map, got %v", tiles)
+	}
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// StepFixed updates the state of the actions and advances the game world by exactly n physics updates, independently
+// of the timing controller. It is meant to be used while paused, so that the game world only advances on request.
+// Returns the game state and the collisions that occurred in those physics updates.
+func (a *App) StepFixed(actions map[string]bool, n int) (domain.DebugStep, error) {
+	if n < 0 {
+		return domain.DebugStep{}, errors.New("invalid number of physics updates")
+	}
+
+	steps := a.playerSteps()
+
+	// Each engine step with the physics update rate performs one physics update followed by one update pass.
+	for i := 0; i < n; i++ {
+		if err := a.Step(actions, a.UpdateRate()); err != nil {
+			return domain.DebugStep{}, fmt.Errorf("failed to perform physics update %d: %w", i, err)
+		}
+	}
+
+	return a.debugStep(steps), nil
+}
+
+// StepFrame performs a single update pass without advancing the physics, independently of the timing controller.
+// Returns the game state and the collisions that occurred, if any.
+func (a *App) StepFrame() (domain.DebugStep, error) {
+	steps := a.playerSteps()
+
+	if err := a.gameEngine.Engine().Step(0); err != nil {
+		return domain.DebugStep{}, fmt.Errorf("failed to perform the game step: %w", err)
+	}
+
+	a.output.collect(a.playerObjects)
+
+	return a.debugStep(steps), nil
+}
+
+// playerSteps returns the current physics step of every player.
+func (a *App) playerSteps() []int {
+	steps := make([]int, len(a.players))
+	for i, player := range a.players {
+		steps[i] = player.Debugger.Step()
+	}
+
+	return steps
+}
+
+// debugStep returns the current game state and the collisions of every player that occurred after the given physics
+// steps.
+func (a *App) debugStep(steps []int) domain.DebugStep {
+	step := domain.DebugStep{
+		GameState: a.GameState(),
+	}
+
+	for i, player := range a.players {
+		step.Collisions = append(step.Collisions, player.Debugger.CollisionsSince(steps[i])...)
+	}
+
+	return step
+}
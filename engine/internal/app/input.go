@@ -0,0 +1,41 @@
+package app
+
+// input defines the structure of the input controller, which keeps the state of the actions between the game steps
+// and latches the actions pressed between two physics updates.
+type input struct {
+	held    map[string]bool // Defines the current state of the actions.
+	pressed map[string]bool // Defines the actions pressed since the last physics update.
+}
+
+// newInput returns a new input controller with every action released.
+func newInput() input {
+	return input{
+		held:    make(map[string]bool),
+		pressed: make(map[string]bool),
+	}
+}
+
+// update updates the state of the given actions and latches the pressed ones.
+func (i *input) update(actions map[string]bool) {
+	for action, state := range actions {
+		if state {
+			i.pressed[action] = true
+		}
+		i.held[action] = state
+	}
+}
+
+// tick returns the state of the actions for the next physics update and clears the latched actions. An action pressed
+// since the last physics update is performed in the next one, even if it was released in the meantime, so that short
+// taps are not dropped at high display rates. A release followed by a new press between two physics updates is not
+// latched, and the action is performed without interruption.
+func (i *input) tick() map[string]bool {
+	actions := make(map[string]bool, len(i.held))
+	for action, state := range i.held {
+		actions[action] = state || i.pressed[action]
+	}
+
+	clear(i.pressed)
+
+	return actions
+}
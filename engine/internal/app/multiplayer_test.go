@@ -0,0 +1,68 @@
+package app_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestPlayerCollision(t *testing.T) {
+	// Draw an empty map with a floor at the bottom, and spawn the second player right above the first one.
+	s := gametest.NewScenario(t, gametest.Floor(12, "#####")...)
+	engineConfig, playerConfig := s.Engine, s.Player
+
+	standing := s.Standing(2, s.Map.Height-1)
+	engineConfig.Players = []config.PlayerSlot{
+		{ActionPrefix: "p1", Position: standing},
+		{ActionPrefix: "p2", Position: vector2.Vector2{X: standing.X, Y: standing.Y + 2*playerConfig.Object.ColliderSize.Y}},
+	}
+
+	tests := []struct {
+		name            string
+		playerCollision bool
+		expected        float64 // Defines the expected position on the y-axis of the second player.
+	}{
+		{name: "ignored", playerCollision: false, expected: standing.Y},
+		{name: "enabled", playerCollision: true, expected: standing.Y + playerConfig.Object.ColliderSize.Y},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engineConfig.PlayerCollision = tt.playerCollision
+
+			a := app.New(engineConfig, playerConfig, s.Map)
+			if err := a.StartGameWorld(); err != nil {
+				t.Fatalf("failed to start game world: %v", err)
+			}
+
+			for i := 0; i < 150; i++ {
+				if err := a.Step(nil, a.UpdateRate()); err != nil {
+					t.Fatalf("failed to step tick %d: %v", i, err)
+				}
+			}
+
+			players := a.Players()
+			if len(players) != 2 {
+				t.Fatalf("expected 2 players, got %d", len(players))
+			}
+
+			// The first player stands on the floor in both cases.
+			if y := players[0].Position.Y; math.Abs(y-standing.Y) > 1 {
+				t.Errorf("expected the first player to stand on the floor at %v, got %v", standing.Y, y)
+			}
+			if grounds := a.Debug().Players[0].Grounds; len(grounds) == 0 {
+				t.Error("expected the first player to be grounded")
+			}
+
+			// The second player either passes through the first one onto the floor, or stands on top of it.
+			if y := players[1].Position.Y; math.Abs(y-tt.expected) > 1 {
+				t.Errorf("expected the second player at %v, got %v", tt.expected, y)
+			}
+		})
+	}
+}
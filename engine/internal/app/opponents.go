@@ -0,0 +1,61 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	core "github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+	"github.com/goofr-group/jump-master/engine/internal/game/prefab"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+)
+
+// SetOpponents updates the opponents of a race rendered as ghosts alongside the players, with their latest state
+// received from the race server. The opponents that are no longer given are removed from the game world.
+func (a *App) SetOpponents(opponents []domain.Opponent) error {
+	// Check if the game world has started.
+	if a.runTimer == nil {
+		return errors.New("game world not started")
+	}
+
+	if a.opponents == nil {
+		a.opponents = make(map[int]*core.Object)
+	}
+
+	// Create or update the opponent objects.
+	given := make(map[int]struct{}, len(opponents))
+	for _, opponent := range opponents {
+		given[opponent.ID] = struct{}{}
+
+		object, ok := a.opponents[opponent.ID]
+		if !ok {
+			var err error
+			object, err = prefab.NewOpponent(a.gameEngine, a.playerConfig, a.engineConfig.Ghost)
+			if err != nil {
+				return fmt.Errorf("failed to create opponent prefab: %w", err)
+			}
+
+			a.opponents[opponent.ID] = object
+		}
+
+		object.Transform.Position = opponent.Position
+		object.SetProperty(property.Image, opponent.Image)
+		object.SetProperty(property.FlipHorizontally, opponent.FlipHorizontally)
+	}
+
+	// Remove the opponents that left the race.
+	for id, object := range a.opponents {
+		if _, ok := given[id]; ok {
+			continue
+		}
+
+		if err := a.gameEngine.DestroyGameObject(object.ID()); err != nil {
+			return fmt.Errorf("failed to remove opponent object: %w", err)
+		}
+
+		delete(a.opponents, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"slices"
+
+	core "github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+)
+
+// output defines the structure of the sounds and events of the players collected across the physics updates, until
+// they are returned in a game state.
+type output struct {
+	sounds map[int64][]string // Defines the sounds of the players to be played, by object identifier.
+	events map[int64][]string // Defines the events of the players that occurred, by object identifier.
+}
+
+// newOutput returns a new empty output.
+func newOutput() output {
+	return output{
+		sounds: make(map[int64][]string),
+		events: make(map[int64][]string),
+	}
+}
+
+// collect adds the sounds and events of the last physics update of the given player objects. Each sound and event is
+// only added once, so that a game state with several physics updates does not play the same sound twice.
+func (o *output) collect(objects []*core.Object) {
+	for _, object := range objects {
+		id := object.ID()
+
+		if sounds, ok := object.Property(property.Sounds).([]string); ok {
+			o.sounds[id] = appendUnique(o.sounds[id], sounds)
+		}
+		if events, ok := object.Property(property.Events).([]string); ok {
+			o.events[id] = appendUnique(o.events[id], events)
+		}
+	}
+}
+
+// clear removes the collected sounds and events, once they were returned in a game state.
+func (o *output) clear() {
+	clear(o.sounds)
+	clear(o.events)
+}
+
+// appendUnique appends the given values that are not in the given list yet.
+func appendUnique(list []string, values []string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"math"
+	"sort"
+
+	"github.com/goofr-group/game-engine/pkg/rendering"
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// parallaxLayers returns the state of the parallax layers in screen space for the given camera, sorted by render
+// order.
+func parallaxLayers(layers []config.ParallaxLayer, camera rendering.Camera) []domain.ParallaxLayer {
+	ppu := camera.PixelsPerUnit
+
+	states := make([]domain.ParallaxLayer, 0, len(layers))
+	for _, layer := range layers {
+		// Move the layer with a fraction of the camera movement. The layer position relative to the camera is scaled
+		// to pixels, with the screen origin in the top left corner and the y-axis pointing downwards.
+		position := vector2.Vector2{
+			X: (layer.Position.X-camera.Position.X*layer.Scroll.X)*ppu + camera.PixelWidth/2,
+			Y: -(layer.Position.Y-camera.Position.Y*layer.Scroll.Y)*ppu + camera.PixelHeight/2,
+		}
+		size := layer.Size.Mul(ppu)
+
+		// Wrap the position of the repeated axes to the first image that is visible on the screen.
+		repeat := layer.Repeat
+		if (repeat == config.ParallaxRepeatX || repeat == config.ParallaxRepeatBoth) && size.X > 0 {
+			position.X = wrap(position.X, size.X)
+		}
+		if (repeat == config.ParallaxRepeatY || repeat == config.ParallaxRepeatBoth) && size.Y > 0 {
+			position.Y = wrap(position.Y, size.Y)
+		}
+		if len(repeat) == 0 {
+			repeat = config.ParallaxRepeatNone
+		}
+
+		states = append(states, domain.ParallaxLayer{
+			Image:    layer.Image,
+			Position: position,
+			Size:     size,
+			Repeat:   repeat,
+			Order:    layer.Order,
+		})
+	}
+
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].Order < states[j].Order
+	})
+
+	return states
+}
+
+// wrap returns the given position wrapped to the range ]-size; 0].
+func wrap(position, size float64) float64 {
+	position = math.Mod(position, size)
+	if position > 0 {
+		position -= size
+	}
+
+	return position
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+	"github.com/goofr-group/jump-master/engine/internal/game/prefab"
+)
+
+// practice defines the structure of the practice mode state.
+type practice struct {
+	enabled   bool                          // Defines if the practice mode is enabled.
+	used      bool                          // Defines if the practice mode was enabled in the current run, which excludes it from the records.
+	bookmarks map[string]behaviour.Snapshot // Defines the saved player states by bookmark name.
+}
+
+// SetPracticeMode enables or disables the practice mode. Once enabled, the current run is excluded from the records,
+// even if the practice mode is disabled afterwards.
+func (a *App) SetPracticeMode(enabled bool) {
+	a.practice.enabled = enabled
+	if enabled {
+		a.practice.used = true
+	}
+}
+
+// Recordable returns true if the current run can be submitted to the records, which is not the case once the practice
+// mode was enabled or the map was edited, or with multiple players.
+func (a *App) Recordable() bool {
+	return !a.practice.used && !a.run.edited && len(a.playerObjects) == 1
+}
+
+// Teleport moves the player with the given index to the given position in world space. Requires the practice mode.
+func (a *App) Teleport(player int, position vector2.Vector2) error {
+	p, err := a.practicePlayer(player)
+	if err != nil {
+		return err
+	}
+
+	p.Practice.Teleport(position)
+
+	return nil
+}
+
+// TeleportLevel moves the player with the given index to the center of the given screen level, keeping its position
+// on the x-axis. Requires the practice mode.
+func (a *App) TeleportLevel(player, level int) error {
+	if level < 0 {
+		return errors.New("invalid screen level")
+	}
+
+	p, err := a.practicePlayer(player)
+	if err != nil {
+		return err
+	}
+
+	cameraConfig := a.engineConfig.Camera
+	position := vector2.Vector2{
+		X: p.Object.Transform.Position.X,
+		Y: cameraConfig.Position.Y + float64(level)*behaviour.ScreenSize(cameraConfig).Y,
+	}
+
+	p.Practice.Teleport(position)
+
+	return nil
+}
+
+// SaveBookmark saves the state of the player with the given index in the bookmark with the given name, replacing any
+// previous bookmark with the same name. Requires the practice mode.
+func (a *App) SaveBookmark(player int, name string) error {
+	p, err := a.practicePlayer(player)
+	if err != nil {
+		return err
+	}
+
+	if a.practice.bookmarks == nil {
+		a.practice.bookmarks = make(map[string]behaviour.Snapshot)
+	}
+
+	a.practice.bookmarks[name] = p.Practice.Snapshot()
+
+	return nil
+}
+
+// LoadBookmark restores the state saved in the bookmark with the given name to the player with the given index.
+// Requires the practice mode.
+func (a *App) LoadBookmark(player int, name string) error {
+	p, err := a.practicePlayer(player)
+	if err != nil {
+		return err
+	}
+
+	snapshot, ok := a.practice.bookmarks[name]
+	if !ok {
+		return fmt.Errorf("bookmark %q not found", name)
+	}
+
+	p.Practice.Restore(snapshot)
+
+	return nil
+}
+
+// PlayerSnapshot returns the state of the player with the given index. Unlike the bookmarks, it does not require the
+// practice mode, so that the game world can be searched from the saved states.
+func (a *App) PlayerSnapshot(player int) (behaviour.Snapshot, error) {
+	if player < 0 || player >= len(a.players) {
+		return behaviour.Snapshot{}, fmt.Errorf("player %d not found", player)
+	}
+
+	return a.players[player].Practice.Snapshot(), nil
+}
+
+// RestorePlayer restores the given state to the player with the given index. Unlike the bookmarks, it does not require
+// the practice mode.
+func (a *App) RestorePlayer(player int, snapshot behaviour.Snapshot) error {
+	if player < 0 || player >= len(a.players) {
+		return fmt.Errorf("player %d not found", player)
+	}
+
+	a.players[player].Practice.Restore(snapshot)
+
+	return nil
+}
+
+// practicePlayer returns the player prefab with the given index, if the practice mode is enabled.
+func (a *App) practicePlayer(player int) (prefab.Player, error) {
+	if !a.practice.enabled {
+		return prefab.Player{}, errors.New("practice mode disabled")
+	}
+
+	if player < 0 || player >= len(a.players) {
+		return prefab.Player{}, fmt.Errorf("player %d not found", player)
+	}
+
+	return a.players[player], nil
+}
@@ -0,0 +1,170 @@
+package app_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// landing steps the world for the given number of physics steps while every action is released, and returns the
+// highest position of the player on the y-axis and its final position.
+func landing(w *gametest.World, ticks int) (float64, vector2.Vector2) {
+	highest := w.Player().Position.Y
+	for i := 0; i < ticks; i++ {
+		w.Wait(1)
+		highest = math.Max(highest, w.Player().Position.Y)
+	}
+
+	return highest, w.Player().Position
+}
+
+func TestTeleportHeldJump(t *testing.T) {
+	w := gametest.New(t)
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+
+	a := w.App()
+	a.SetPracticeMode(true)
+	position := w.Player().Position
+
+	// Teleport the player while the jump is being charged.
+	w.Hold(w.Seconds(0.1), action.Jump)
+	if err := a.Teleport(0, position); err != nil {
+		t.Fatalf("failed to teleport: %v", err)
+	}
+
+	// Holding the jump does not charge it until it is pressed again, and releasing it does not jump.
+	w.Hold(w.Seconds(0.1), action.Jump)
+	if player, _ := w.Debug(); player.Jump.Charge != 0 {
+		t.Errorf("expected no jump charge after the teleport, got %v", player.Jump.Charge)
+	}
+
+	if highest, _ := landing(w, w.Seconds(1)); highest > position.Y+1 {
+		t.Errorf("expected the player to stay on the ground at %v, got up to %v", position.Y, highest)
+	}
+	w.AssertGrounded()
+}
+
+func TestBookmarkJumpState(t *testing.T) {
+	w := gametest.New(t)
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+
+	a := w.App()
+	a.SetPracticeMode(true)
+
+	// Save the player while the jump is being charged, right before it is released with a direction.
+	w.Hold(w.Seconds(0.2), action.Jump)
+	if err := a.SaveBookmark(0, "charge"); err != nil {
+		t.Fatalf("failed to save bookmark: %v", err)
+	}
+
+	w.Hold(1, action.Right)
+	expectedHighest, expectedLanding := landing(w, w.Seconds(3))
+
+	// Restoring the bookmark releases the same jump.
+	if err := a.LoadBookmark(0, "charge"); err != nil {
+		t.Fatalf("failed to load bookmark: %v", err)
+	}
+
+	w.Hold(1, action.Right)
+	highest, end := landing(w, w.Seconds(3))
+
+	if math.Abs(highest-expectedHighest) > 1e-6 {
+		t.Errorf("expected the jump to reach %v, got %v", expectedHighest, highest)
+	}
+	if math.Abs(end.X-expectedLanding.X) > 1e-6 || math.Abs(end.Y-expectedLanding.Y) > 1e-6 {
+		t.Errorf("expected the jump to land in %v, got %v", expectedLanding, end)
+	}
+}
+
+func TestTeleportClearsContacts(t *testing.T) {
+	s := gametest.NewScenario(t, gametest.Floor(12, "#####")...)
+	s.Player.Object.Position = s.Standing(2, s.Map.Height-1)
+
+	w := gametest.New(t, s.Options()...)
+	w.Wait(w.Seconds(1))
+	w.AssertGrounded()
+
+	a := w.App()
+	a.SetPracticeMode(true)
+
+	// Teleport the player into the air, away from the floor it stands on.
+	position := s.Standing(2, 2)
+	if err := a.Teleport(0, position); err != nil {
+		t.Fatalf("failed to teleport: %v", err)
+	}
+
+	if player, _ := w.Debug(); len(player.Grounds) > 0 || len(player.KnockBackPlatforms) > 0 {
+		t.Errorf("expected no contacts after the teleport, got grounds %v and platforms %v", player.Grounds, player.KnockBackPlatforms)
+	}
+
+	// The player falls without charging a jump, and lands on the floor again.
+	w.Hold(2, action.Jump)
+	w.AssertAirborne()
+	if player, _ := w.Debug(); player.Jump.Charge != 0 {
+		t.Errorf("expected no jump charge in the air, got %v", player.Jump.Charge)
+	}
+
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+	w.AssertPositionNear(s.Standing(2, s.Map.Height-1), 1)
+}
+
+func TestRestorePlayerContinuesRun(t *testing.T) {
+	before := []replay.Input{
+		{Ticks: 75, Actions: action.States()},
+		{Ticks: 15, Actions: action.States(action.Jump)},
+		{Ticks: 1, Actions: action.States(action.Right)},
+		{Ticks: 150, Actions: action.States()},
+	}
+	after := []replay.Input{
+		{Ticks: 20, Actions: action.States(action.Jump)},
+		{Ticks: 1, Actions: action.States(action.Left)},
+		{Ticks: 150, Actions: action.States()},
+	}
+
+	// Replay the inputs from the spawn position, saving the player between them.
+	expected := gametest.New(t)
+	expected.Run(before...)
+
+	snapshot, err := expected.App().PlayerSnapshot(0)
+	if err != nil {
+		t.Fatalf("failed to snapshot player: %v", err)
+	}
+
+	// Restore the player in a world where it moved elsewhere, without the practice mode.
+	restored := gametest.New(t)
+	restored.Hold(75, action.Right)
+	restored.Wait(75)
+
+	if err = restored.App().RestorePlayer(0, snapshot); err != nil {
+		t.Fatalf("failed to restore player: %v", err)
+	}
+
+	// The restored player continues exactly like the replayed one.
+	for _, input := range after {
+		for i := 0; i < input.Ticks; i++ {
+			expected.Step(1, input.Actions)
+			restored.Step(1, input.Actions)
+
+			want, got := expected.Player(), restored.Player()
+			if got.Position != want.Position || got.Velocity != want.Velocity {
+				t.Fatalf("tick %d: expected position %v and velocity %v, got %v and %v",
+					restored.Ticks(), want.Position, want.Velocity, got.Position, got.Velocity)
+			}
+		}
+	}
+
+	expectedDebug, _ := expected.Debug()
+	restoredDebug, _ := restored.Debug()
+	if !reflect.DeepEqual(restoredDebug.Grounds, expectedDebug.Grounds) {
+		t.Errorf("expected the grounds %v, got %v", expectedDebug.Grounds, restoredDebug.Grounds)
+	}
+}
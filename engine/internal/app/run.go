@@ -0,0 +1,69 @@
+package app
+
+import (
+	"errors"
+	"math"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// run defines the structure of the state of the current run, which records the actions of every physics update so
+// that the run can be submitted as a replay.
+type run struct {
+	recorder replay.Recorder // Defines the recorder of the actions of the run.
+	result   replay.Result   // Defines the current result of the run.
+	edited   bool            // Defines if the map was edited during the run, which excludes it from the records.
+}
+
+// recordTick records the actions of the last physics update and updates the result of the run with the state of the
+// players.
+func (a *App) recordTick(actions map[string]bool) {
+	a.run.recorder.Record(actions)
+
+	result := &a.run.result
+	result.Ticks++
+	completed := result.Completed
+
+	goal := a.mapConfig.Goal
+	goalRect := grid.AreaRect(a.mapConfig, goal.X, goal.Y, goal.Width, goal.Height)
+
+	for _, object := range a.playerObjects {
+		position := object.Transform.Position
+
+		result.Height = math.Max(result.Height, position.Y-a.playerConfig.Object.Position.Y)
+		result.Position = position
+
+		// The run is completed when a player reaches the goal area, if any.
+		if goal.Width > 0 && goal.Height > 0 && goalRect.Contains(position) {
+			result.Completed = true
+		}
+	}
+
+	result.Level = max(result.Level, a.Level())
+
+	// The time stops once the run is completed.
+	if !completed {
+		result.Time = float64(result.Ticks) * a.UpdateRate()
+	}
+}
+
+// Result returns the current result of the run. The height is measured from the spawn position of the player
+// configuration, and the time is the completion time once a player reached the goal area of the map.
+func (a *App) Result() replay.Result {
+	return a.run.result
+}
+
+// Replay returns the replay of the current run played with the given configuration version. Fails if the run cannot
+// be submitted to the records.
+func (a *App) Replay(configVersion string) (replay.Replay, error) {
+	if !a.Recordable() {
+		return replay.Replay{}, errors.New("run not recordable")
+	}
+
+	return replay.Replay{
+		ConfigVersion: configVersion,
+		Inputs:        a.run.recorder.Inputs(),
+		Result:        a.run.result,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package app_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+	"github.com/goofr-group/jump-master/engine/internal/game/sound"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+// stepFrames steps the world for the given duration in seconds with frames of the given display rate, while the given
+// actions are held and the others are released. Returns the highest position of the player in those frames.
+func stepFrames(t *testing.T, w *gametest.World, fps, seconds float64, held ...string) float64 {
+	t.Helper()
+
+	highest := w.Player().Position.Y
+	for i := 0; i < int(math.Round(seconds*fps)); i++ {
+		if err := w.App().Step(action.States(held...), 1/fps); err != nil {
+			t.Fatalf("failed to step frame %d: %v", i, err)
+		}
+
+		highest = math.Max(highest, w.Player().Position.Y)
+	}
+
+	return highest
+}
+
+func TestStepFrameRateIndependence(t *testing.T) {
+	var expected *vector2.Vector2
+
+	for _, fps := range []float64{30, 60, 144} {
+		w := gametest.New(t)
+		w.Wait(w.Seconds(1))
+		w.AssertGrounded()
+		start := w.Player().Position
+
+		// The durations are multiples of the frame durations and the physics update rate, so that every display rate
+		// holds the jump action for the same physics updates.
+		stepFrames(t, w, fps, 1.0/3, action.Jump)
+		highest := stepFrames(t, w, fps, 2)
+
+		if highest <= start.Y {
+			t.Fatalf("%v fps: expected the player to jump from %v", fps, start)
+		}
+		w.AssertGrounded()
+
+		position := w.Player().Position
+		if expected == nil {
+			expected = &position
+			continue
+		}
+
+		if math.Abs(position.X-expected.X) > 1e-6 || math.Abs(position.Y-expected.Y) > 1e-6 {
+			t.Errorf("%v fps: expected the jump to land in %v, got %v", fps, *expected, position)
+		}
+	}
+}
+
+func TestStepLatchesTaps(t *testing.T) {
+	w := gametest.New(t)
+	w.Wait(w.Seconds(1))
+	w.AssertGrounded()
+	start := w.Player().Position
+
+	// Press and release the jump action between two physics updates.
+	const fps = 1000
+	stepFrames(t, w, fps, 1.0/fps, action.Jump)
+	highest := stepFrames(t, w, fps, 1)
+
+	if highest <= start.Y {
+		t.Errorf("expected the tap to jump from %v, highest position %v", start, highest)
+	}
+}
+
+func TestStepSounds(t *testing.T) {
+	// Display rates above and below the physics update rate, so that the frames run zero, one or several updates.
+	for _, fps := range []float64{30, 240} {
+		w := gametest.New(t)
+		w.Wait(w.Seconds(1))
+		w.AssertGrounded()
+		a := w.App()
+
+		// Discard the sounds of the landing on the spawn platform.
+		a.GameState()
+
+		// Tap a short jump and land, while counting the sounds of the rendered frames.
+		counts := make(map[string]int)
+		for i := 0; i < int(math.Round(3*fps)); i++ {
+			held := action.States()
+			if i < int(math.Round(fps/10)) {
+				held = action.States(action.Jump)
+			}
+
+			if err := a.Step(held, 1/fps); err != nil {
+				t.Fatalf("%v fps: failed to step frame %d: %v", fps, i, err)
+			}
+
+			for _, object := range a.GameState().GameObjects {
+				if object.Tag != tag.Player {
+					continue
+				}
+
+				sounds, _ := object.Property(property.Sounds).([]string)
+				for _, s := range sounds {
+					counts[s]++
+				}
+			}
+		}
+
+		// Every sound is played once, whether the frames run zero or several physics updates.
+		for _, s := range []string{sound.Jump, sound.Landing} {
+			if counts[s] != 1 {
+				t.Errorf("%v fps: expected the %q sound to be played once, got %d", fps, s, counts[s])
+			}
+		}
+	}
+}
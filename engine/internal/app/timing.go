@@ -0,0 +1,88 @@
+package app
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/goofr-group/go-math/mathf"
+)
+
+// accumulatorTolerance defines the tolerance, in seconds, of the accumulated time when checking for the next physics
+// update.
+const accumulatorTolerance = 1e-9
+
+// timing defines the structure of the timing controller, which converts the wall-clock time elapsed between game
+// steps into the time step of the engine.
+type timing struct {
+	lastStep    time.Time // Defines the time when the last step occurred.
+	paused      bool      // Defines if the game is paused.
+	timeScale   float64   // Defines the scale applied to the elapsed time.
+	maxTimeStep float64   // Defines the maximum elapsed time, in seconds, considered in a single step.
+	accumulator float64   // Defines the time, in seconds, of the game steps that has not been simulated by a physics update yet.
+}
+
+// newTiming returns a new timing controller with the given maximum time step. A non-positive maximum time step does
+// not limit the elapsed time.
+func newTiming(maxTimeStep float64) timing {
+	return timing{
+		lastStep:    time.Now(),
+		timeScale:   1,
+		maxTimeStep: maxTimeStep,
+	}
+}
+
+// timeStep returns the time step of the engine for the wall-clock time elapsed since the last step. The elapsed time
+// is limited to the maximum time step, so that long hitches (e.g. switching browser tabs) do not cause a burst of
+// catch-up physics, and then scaled by the time scale. Returns 0 while paused.
+func (t *timing) timeStep(now time.Time) float64 {
+	elapsed := now.Sub(t.lastStep).Seconds()
+	t.lastStep = now
+
+	if t.paused {
+		return 0
+	}
+
+	if t.maxTimeStep > 0 {
+		elapsed = math.Min(elapsed, t.maxTimeStep)
+	}
+
+	return elapsed * t.timeScale
+}
+
+// alpha returns the fraction of the next physics update that has elapsed, in the range [0; 1], used to interpolate
+// the rendered positions between the last two physics updates.
+func (t *timing) alpha(fixedDeltaTime float64) float64 {
+	if fixedDeltaTime <= 0 {
+		return 0
+	}
+
+	return mathf.Clamp(t.accumulator/fixedDeltaTime, 0, 1)
+}
+
+// Pause pauses the game. While paused, the game steps do not advance the game world.
+func (a *App) Pause() {
+	a.timing.paused = true
+}
+
+// Resume resumes the game. The time elapsed while paused is discarded.
+func (a *App) Resume() {
+	a.timing.paused = false
+	a.timing.lastStep = time.Now()
+}
+
+// Paused returns true if the game is paused.
+func (a *App) Paused() bool {
+	return a.timing.paused
+}
+
+// SetTimeScale sets the scale applied to the elapsed time of the game steps (e.g. 0.5 for half speed).
+func (a *App) SetTimeScale(timeScale float64) error {
+	if timeScale <= 0 || math.IsInf(timeScale, 0) || math.IsNaN(timeScale) {
+		return errors.New("invalid time scale")
+	}
+
+	a.timing.timeScale = timeScale
+
+	return nil
+}
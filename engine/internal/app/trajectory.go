@@ -0,0 +1,172 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/goofr-group/go-math/rotation/matrix"
+	"github.com/goofr-group/go-math/vector2"
+	core "github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
+)
+
+const (
+	// maxSettleDuration defines the maximum duration, in seconds, simulated for the player to land before the jump.
+	maxSettleDuration = 2
+	// maxFlightDuration defines the maximum duration, in seconds, simulated for the jump before giving up on a landing.
+	maxFlightDuration = 10
+	// chargeTolerance defines the tolerance used to round the number of physics updates needed to reach a charge.
+	chargeTolerance = 1e-9
+)
+
+// PredictJump predicts the arc of a jump released with the given charge, in the range [0; 1], and direction (Left,
+// Right or empty for a vertical jump) by a player standing at the given position. The jump is simulated in a separate
+// game world with the same configurations, so the prediction includes the same impulse, angle, gravity, knock-back
+// and ceiling hits as the game, and stops at the first landing. The separate game world is reused by the next
+// predictions until the map is edited.
+func (a *App) PredictJump(position vector2.Vector2, charge float64, direction string) (domain.Trajectory, error) {
+	if charge < 0 || charge > 1 || math.IsNaN(charge) {
+		return domain.Trajectory{}, errors.New("invalid jump charge")
+	}
+	if direction != "" && direction != action.Left && direction != action.Right {
+		return domain.Trajectory{}, fmt.Errorf("invalid jump direction %q", direction)
+	}
+
+	sandbox, err := a.predictionWorld(position)
+	if err != nil {
+		return domain.Trajectory{}, err
+	}
+
+	updateRate := sandbox.UpdateRate()
+	ticks := func(seconds float64) int {
+		return int(math.Ceil(seconds / updateRate))
+	}
+
+	// Wait for the player to land, so that the jump can be charged. The ground contacts are updated in the physics
+	// updates, so the world is stepped at least once after the player is moved.
+	released := action.States()
+	for i := 0; i == 0 || !sandbox.grounded(); i++ {
+		if i >= ticks(maxSettleDuration) {
+			return domain.Trajectory{}, errors.New("player does not land on the given position")
+		}
+
+		if err := sandbox.Step(released, updateRate); err != nil {
+			return domain.Trajectory{}, err
+		}
+	}
+
+	// Charge the jump for the number of physics updates needed to reach the given charge.
+	var chargeTicks int
+	if jumpConfig := a.playerConfig.Jump; jumpConfig.Impulse > 0 {
+		chargeTicks = int(math.Ceil(charge*jumpConfig.MaxImpulse/(jumpConfig.Impulse*updateRate) - chargeTolerance))
+	}
+
+	charging := action.States(action.Jump)
+	for i := 0; i < max(chargeTicks, 1); i++ {
+		if err := sandbox.Step(charging, updateRate); err != nil {
+			return domain.Trajectory{}, err
+		}
+	}
+
+	// Release the jump while holding the direction, and follow the player until it lands.
+	releasing := action.States()
+	if direction != "" {
+		releasing = action.States(direction)
+	}
+
+	var trajectory domain.Trajectory
+	airborne := false
+	for i := 0; i < ticks(maxFlightDuration); i++ {
+		actions := released
+		if i == 0 {
+			actions = releasing
+		}
+
+		if err := sandbox.Step(actions, updateRate); err != nil {
+			return domain.Trajectory{}, err
+		}
+
+		player := sandbox.Players()[0]
+		trajectory.Points = append(trajectory.Points, player.Position)
+		trajectory.Ticks++
+		if slices.Contains(player.Events, event.KnockBack) {
+			trajectory.KnockBacks++
+		}
+
+		grounded := sandbox.grounded()
+		if !grounded {
+			airborne = true
+			continue
+		}
+		if airborne {
+			trajectory.Landing = player.Position
+			trajectory.Landed = true
+			break
+		}
+	}
+
+	return trajectory, nil
+}
+
+// predictionWorld returns the separate game world used to predict the jumps, with its player moved to the given
+// position at rest. The game world is created on the first prediction, and the next ones only reset its player.
+func (a *App) predictionWorld(position vector2.Vector2) (*App, error) {
+	if a.sandbox != nil && len(a.sandbox.players) != 0 {
+		// Reset the state of the player behaviours and discard the run of the previous prediction.
+		a.sandbox.players[0].Practice.Teleport(position)
+		a.sandbox.run = run{}
+
+		return a.sandbox, nil
+	}
+
+	// Start a separate game world with a single player in the given position.
+	engineConfig := a.engineConfig
+	engineConfig.Players = []config.PlayerSlot{{Position: position}}
+	engineConfig.Camera.Shake.Enabled = false
+
+	sandbox := New(engineConfig, a.playerConfig, a.mapConfig)
+	if err := sandbox.StartGameWorld(); err != nil {
+		return nil, fmt.Errorf("failed to start prediction world: %w", err)
+	}
+
+	a.sandbox = sandbox
+
+	return sandbox, nil
+}
+
+// WorldToScreen converts the given position in world space to screen space, with the current camera.
+func (a *App) WorldToScreen(position vector2.Vector2) vector2.Vector2 {
+	camera := a.camera(a.timing.alpha(a.UpdateRate()))
+	transform := core.Transform2D{
+		Position: position,
+		Rotation: matrix.Identity(),
+		Scale:    vector2.One(),
+	}
+	camera.WorldToScreenTransform(&transform)
+
+	return transform.Position
+}
+
+// PlayerPosition returns the position of the player with the given index in world space.
+func (a *App) PlayerPosition(player int) (vector2.Vector2, error) {
+	if player < 0 || player >= len(a.playerObjects) {
+		return vector2.Vector2{}, fmt.Errorf("player %d not found", player)
+	}
+
+	return a.playerObjects[player].Transform.Position, nil
+}
+
+// grounded returns true if the first player is touching the ground.
+func (a *App) grounded() bool {
+	if len(a.players) == 0 {
+		return false
+	}
+
+	return len(a.players[0].Debugger.Debug().Grounds) > 0
+}
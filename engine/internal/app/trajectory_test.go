@@ -0,0 +1,43 @@
+package app_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestPredictJumpReusesWorld(t *testing.T) {
+	w := gametest.New(t)
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+
+	a := w.App()
+	position := w.Player().Position
+
+	expected, err := a.PredictJump(position, 0.5, action.Right)
+	if err != nil {
+		t.Fatalf("failed to predict jump: %v", err)
+	}
+	if !expected.Landed {
+		t.Fatal("expected the predicted jump to land")
+	}
+
+	// A different jump in between does not change the next prediction of the same jump.
+	if _, err = a.PredictJump(position, 1, action.Left); err != nil {
+		t.Fatalf("failed to predict jump: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		trajectory, err := a.PredictJump(position, 0.5, action.Right)
+		if err != nil {
+			t.Fatalf("prediction %d: failed to predict jump: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(trajectory, expected) {
+			t.Errorf("prediction %d: expected the same trajectory, landing in %v after %d ticks, got %v after %d ticks",
+				i, expected.Landing, expected.Ticks, trajectory.Landing, trajectory.Ticks)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"errors"
+	"math"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+)
+
+// Resize resizes the camera to the given viewport size in pixels. The pixels per unit are recomputed so that the
+// configured screen is scaled with the configured viewport policy, keeping the screen levels unchanged. Fails if the
+// configured pixels per unit are not positive.
+func (a *App) Resize(width, height float64) error {
+	if width <= 0 || height <= 0 {
+		return errors.New("invalid viewport size")
+	}
+
+	if a.engineConfig.Camera.PPU <= 0 {
+		return errors.New("invalid camera pixels per unit")
+	}
+
+	pixelSize, ppu := viewport(behaviour.ScreenSize(a.engineConfig.Camera), vector2.Vector2{X: width, Y: height}, a.engineConfig.Camera.Viewport)
+
+	camera := a.gameEngine.Camera()
+	camera.PixelWidth = pixelSize.X
+	camera.PixelHeight = pixelSize.Y
+	camera.PixelsPerUnit = ppu
+
+	return nil
+}
+
+// viewport returns the size in pixels of the camera and its pixels per unit, for a screen with the given size in
+// world units shown in a viewport with the given size in pixels. With the letterbox policy, the whole screen is
+// visible and the camera can be smaller than the viewport. With the fill policy, the camera has the size of the
+// viewport and the parts of the screen that do not fit are cropped.
+func viewport(screenSize, viewportSize vector2.Vector2, policy string) (vector2.Vector2, float64) {
+	scaleX := viewportSize.X / screenSize.X
+	scaleY := viewportSize.Y / screenSize.Y
+
+	switch policy {
+	case config.ViewportFill:
+		return viewportSize, math.Max(scaleX, scaleY)
+
+	default:
+		ppu := math.Min(scaleX, scaleY)
+		return screenSize.Mul(ppu), ppu
+	}
+}
@@ -0,0 +1,89 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestResize(t *testing.T) {
+	engineConfig, _, _ := gametest.Configs(t)
+
+	// The screen in world units, which the screen levels are measured in.
+	screenWidth := engineConfig.Camera.Width / engineConfig.Camera.PPU
+	screenHeight := engineConfig.Camera.Height / engineConfig.Camera.PPU
+
+	tests := []struct {
+		ppu            float64
+		policy         string
+		viewportWidth  float64
+		viewportHeight float64
+		expectedWidth  float64 // Defines the expected width of the camera in pixels.
+		expectedHeight float64 // Defines the expected height of the camera in pixels.
+		expectedPPU    float64
+	}{
+		{ppu: 0.5, policy: config.ViewportLetterbox, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: screenWidth, expectedHeight: screenHeight, expectedPPU: 1},
+		{ppu: 1, policy: config.ViewportLetterbox, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: screenWidth, expectedHeight: screenHeight, expectedPPU: 1},
+		{ppu: 2, policy: config.ViewportLetterbox, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: screenWidth, expectedHeight: screenHeight, expectedPPU: 1},
+		{ppu: 0.5, policy: config.ViewportFill, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: 2 * screenWidth, expectedHeight: screenHeight, expectedPPU: 2},
+		{ppu: 1, policy: config.ViewportFill, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: 2 * screenWidth, expectedHeight: screenHeight, expectedPPU: 2},
+		{ppu: 2, policy: config.ViewportFill, viewportWidth: 2 * screenWidth, viewportHeight: screenHeight, expectedWidth: 2 * screenWidth, expectedHeight: screenHeight, expectedPPU: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s ppu %v", tt.policy, tt.ppu), func(t *testing.T) {
+			// Configure the same screen in world units with the given pixels per unit.
+			cameraConfig := engineConfig
+			cameraConfig.Camera.Width = screenWidth * tt.ppu
+			cameraConfig.Camera.Height = screenHeight * tt.ppu
+			cameraConfig.Camera.PPU = tt.ppu
+			cameraConfig.Camera.Viewport = tt.policy
+
+			w := gametest.New(t, gametest.WithEngine(cameraConfig))
+			a := w.App()
+
+			if err := a.Resize(tt.viewportWidth, tt.viewportHeight); err != nil {
+				t.Fatalf("failed to resize: %v", err)
+			}
+
+			camera := a.GameState().Camera
+			if camera.PixelWidth != tt.expectedWidth || camera.PixelHeight != tt.expectedHeight {
+				t.Errorf("expected camera size %vx%v, got %vx%v", tt.expectedWidth, tt.expectedHeight, camera.PixelWidth, camera.PixelHeight)
+			}
+			if camera.PixelsPerUnit != tt.expectedPPU {
+				t.Errorf("expected %v pixels per unit, got %v", tt.expectedPPU, camera.PixelsPerUnit)
+			}
+
+			// The screen levels do not depend on the pixels per unit.
+			w.Wait(w.Seconds(1))
+			w.AssertCameraLevel(0)
+
+			a.SetPracticeMode(true)
+			if err := a.TeleportLevel(0, 1); err != nil {
+				t.Fatalf("failed to teleport: %v", err)
+			}
+
+			w.Wait(2)
+			if level := a.Level(); level != 1 {
+				t.Errorf("expected level 1, got %d", level)
+			}
+			w.AssertCameraLevel(1)
+		})
+	}
+}
+
+func TestResizeInvalidPPU(t *testing.T) {
+	engineConfig, _, _ := gametest.Configs(t)
+
+	for _, ppu := range []float64{0, -1} {
+		engineConfig.Camera.PPU = ppu
+
+		a := app.New(engineConfig, config.Player{}, config.Map{})
+		if err := a.Resize(1000, 844); err == nil {
+			t.Errorf("ppu %v: expected the resize to fail", ppu)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Package bot searches for the inputs that climb a map from the spawn position to a target height, by simulating the
+// game world headlessly with deterministic steps.
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/analysis"
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+	"github.com/goofr-group/jump-master/engine/internal/verifier"
+)
+
+const (
+	// maxLandingDuration defines the maximum duration, in seconds, simulated for the player to land after a jump.
+	maxLandingDuration = 10
+	// restingVelocity defines the maximum vertical speed, in world units per second, of a player at rest.
+	restingVelocity = 1
+)
+
+// ErrUnsolved is returned when no inputs reaching the target height are found.
+var ErrUnsolved = errors.New("target height not reached")
+
+// Options defines the structure of the solver options.
+type Options struct {
+	TargetHeight float64 // Defines the position on the y-axis the player must reach. If not defined, the highest surface of the map is used.
+	BeamWidth    int     // Defines the number of landing states kept after each jump.
+	MaxJumps     int     // Defines the maximum number of jumps of the route.
+	ChargeLevels int     // Defines the number of jump charges tried, evenly distributed from no charge to the full charge.
+	WalkTicks    int     // Defines the number of physics steps the player walks to each side before jumping. If not defined, the player only jumps from the landing position.
+}
+
+// move defines the inputs of a jump: an optional walk, the jump charge and the direction held on release.
+type move struct {
+	walk      int    // Defines the number of physics steps walked before the jump, where negative values walk left.
+	charge    int    // Defines the number of physics steps the jump is charged.
+	direction string // Defines the direction held when the jump is released, or empty for a vertical jump.
+}
+
+// state defines a landing state of the search.
+type state struct {
+	inputs   []replay.Input     // Defines the inputs from the spawn position to the landing state.
+	snapshot behaviour.Snapshot // Defines the state of the player, used to continue the search from the landing state.
+	position vector2.Vector2
+	ticks    int
+}
+
+// Solver defines the structure of the route solver.
+type Solver struct {
+	engineConfig  config.Engine
+	playerConfig  config.Player
+	mapConfig     config.Map
+	configVersion string
+	options       Options
+}
+
+// New returns a new solver for the given configurations and their version. Routes are simulated with a single player
+// using the actions without a namespace, in the same way as the replays.
+func New(engineConfig config.Engine, playerConfig config.Player, mapConfig config.Map, configVersion string, options Options) Solver {
+	engineConfig.Players = nil
+
+	if options.BeamWidth <= 0 {
+		options.BeamWidth = 1
+	}
+
+	return Solver{
+		engineConfig:  engineConfig,
+		playerConfig:  playerConfig,
+		mapConfig:     mapConfig,
+		configVersion: configVersion,
+		options:       options,
+	}
+}
+
+// Solve searches for the inputs that reach the target height with a beam search over the landing states after each
+// jump, keeping the highest ones. The moves are simulated in a single game world, restoring the player to each landing
+// state, and the route is then verified from the spawn position. Returns the replay of the route to the highest
+// landing state found, where the search stops at the first jump that reaches the target height. If the verified route
+// does not reach the target height, its replay is returned with ErrUnsolved.
+func (s Solver) Solve() (replay.Replay, error) {
+	target := s.target()
+
+	a := app.New(s.engineConfig, s.playerConfig, s.mapConfig)
+	if err := a.StartGameWorld(); err != nil {
+		return replay.Replay{}, fmt.Errorf("failed to start game world: %w", err)
+	}
+
+	// Let the player land on the spawn position.
+	start, ok, err := s.simulate(a, state{}, nil)
+	if err != nil {
+		return replay.Replay{}, err
+	}
+	if !ok {
+		return replay.Replay{}, errors.New("player does not land on the spawn position")
+	}
+
+	best := start
+	beam := []state{start}
+	moves := s.moves()
+	tileSize := float64(s.mapConfig.TileSize)
+
+	for jump := 0; jump < s.options.MaxJumps && best.position.Y < target; jump++ {
+		// Expand every state of the beam, keeping the fastest state per landing tile.
+		landings := make(map[[2]int]state)
+		for _, current := range beam {
+			for _, m := range moves {
+				next, ok, err := s.simulate(a, current, &m)
+				if err != nil {
+					return replay.Replay{}, err
+				}
+				if !ok {
+					continue
+				}
+
+				key := [2]int{int(math.Floor(next.position.X / tileSize)), int(math.Floor(next.position.Y / tileSize))}
+				if previous, ok := landings[key]; !ok || next.ticks < previous.ticks {
+					landings[key] = next
+				}
+			}
+		}
+
+		if len(landings) == 0 {
+			break
+		}
+
+		// Keep the highest landing states.
+		beam = beam[:0]
+		for _, landing := range landings {
+			beam = append(beam, landing)
+		}
+		sort.Slice(beam, func(i, j int) bool {
+			if beam[i].position.Y != beam[j].position.Y {
+				return beam[i].position.Y > beam[j].position.Y
+			}
+			return beam[i].ticks < beam[j].ticks
+		})
+		beam = beam[:min(len(beam), s.options.BeamWidth)]
+
+		if beam[0].position.Y > best.position.Y {
+			best = beam[0]
+		}
+	}
+
+	r, err := s.replay(best.inputs)
+	if err != nil {
+		return replay.Replay{}, err
+	}
+
+	if reached := r.Result.Position.Y; reached < target {
+		return r, fmt.Errorf("%w: reached %f of %f", ErrUnsolved, reached, target)
+	}
+
+	return r, nil
+}
+
+// target returns the position on the y-axis the player must reach.
+func (s Solver) target() float64 {
+	if s.options.TargetHeight != 0 {
+		return s.options.TargetHeight
+	}
+
+	// Use the position of the player standing on the highest surface, with a tolerance of half a tile.
+	target := math.Inf(-1)
+	for _, surface := range analysis.Surfaces(s.mapConfig, s.playerConfig) {
+		target = math.Max(target, surface.Top-s.playerConfig.Object.ColliderOffset.Y)
+	}
+
+	return target - float64(s.mapConfig.TileSize)/2
+}
+
+// moves returns the moves tried from each landing state.
+func (s Solver) moves() []move {
+	jumpConfig := s.playerConfig.Jump
+	updateRate := s.engineConfig.Physics.UpdateRate
+
+	// Get the number of physics steps needed to fully charge the jump.
+	maxCharge := 1
+	if jumpConfig.Impulse > 0 && updateRate > 0 {
+		maxCharge = int(math.Ceil(jumpConfig.MaxImpulse / (jumpConfig.Impulse * updateRate)))
+	}
+
+	levels := max(s.options.ChargeLevels, 1)
+	walks := []int{0}
+	if s.options.WalkTicks > 0 {
+		walks = append(walks, -s.options.WalkTicks, s.options.WalkTicks)
+	}
+
+	var moves []move
+	for _, walk := range walks {
+		for level := 1; level <= levels; level++ {
+			for _, direction := range []string{"", action.Left, action.Right} {
+				moves = append(moves, move{
+					walk:      walk,
+					charge:    max(int(math.Round(float64(maxCharge*level)/float64(levels))), 1),
+					direction: direction,
+				})
+			}
+		}
+	}
+
+	return moves
+}
+
+// simulate restores the player of the given game world to the given landing state, performs the given move, and
+// returns the state once the player has landed and is at rest. Without a move, the player is not restored and the
+// state is returned once the player is at rest. Returns false if the player does not land.
+func (s Solver) simulate(a *app.App, from state, m *move) (state, bool, error) {
+	if m != nil {
+		if err := a.RestorePlayer(0, from.snapshot); err != nil {
+			return state{}, false, fmt.Errorf("failed to restore player: %w", err)
+		}
+	}
+
+	var recorder replay.Recorder
+	ticks := from.ticks
+	step := func(actions map[string]bool, n int) error {
+		for i := 0; i < n; i++ {
+			if err := a.Step(actions, a.UpdateRate()); err != nil {
+				return fmt.Errorf("failed to step tick %d: %w", ticks, err)
+			}
+
+			recorder.Record(actions)
+			ticks++
+		}
+
+		return nil
+	}
+
+	// Perform the move.
+	if m != nil {
+		if m.walk < 0 {
+			if err := step(action.States(action.Left), -m.walk); err != nil {
+				return state{}, false, err
+			}
+		} else if m.walk > 0 {
+			if err := step(action.States(action.Right), m.walk); err != nil {
+				return state{}, false, err
+			}
+		}
+
+		if err := step(action.States(action.Jump), m.charge); err != nil {
+			return state{}, false, err
+		}
+
+		release := action.States()
+		if m.direction != "" {
+			release = action.States(m.direction)
+		}
+
+		if err := step(release, 1); err != nil {
+			return state{}, false, err
+		}
+	}
+
+	// Wait for the player to land and recover.
+	maxTicks := int(math.Ceil(maxLandingDuration / a.UpdateRate()))
+	for i := 0; !atRest(a); i++ {
+		if i >= maxTicks {
+			return state{}, false, nil
+		}
+
+		if err := step(action.States(), 1); err != nil {
+			return state{}, false, err
+		}
+	}
+
+	snapshot, err := a.PlayerSnapshot(0)
+	if err != nil {
+		return state{}, false, fmt.Errorf("failed to snapshot player: %w", err)
+	}
+
+	return state{
+		inputs:   append(slices.Clone(from.inputs), recorder.Inputs()...),
+		snapshot: snapshot,
+		position: a.Players()[0].Position,
+		ticks:    ticks,
+	}, true, nil
+}
+
+// replay returns the replay of the given inputs, with the result claimed by the replay verifier.
+func (s Solver) replay(inputs []replay.Input) (replay.Replay, error) {
+	v := verifier.New(s.engineConfig, s.playerConfig, s.mapConfig, s.configVersion)
+
+	result, err := v.Simulate(inputs)
+	if err != nil {
+		return replay.Replay{}, fmt.Errorf("failed to simulate route: %w", err)
+	}
+
+	return replay.Replay{
+		ConfigVersion: s.configVersion,
+		Inputs:        inputs,
+		Result:        result,
+	}, nil
+}
+
+// atRest returns true if the player is on the ground, not stunned and not moving vertically.
+func atRest(a *app.App) bool {
+	debug := a.Debug()
+	players := a.Players()
+	if len(debug.Players) == 0 || len(players) == 0 {
+		return false
+	}
+
+	return len(debug.Players[0].Grounds) > 0 && debug.Players[0].Fall.StunTimer <= 0 &&
+		math.Abs(players[0].Velocity.Y) <= restingVelocity
+}
@@ -0,0 +1,51 @@
+package bot_test
+
+import (
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/bot"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+	"github.com/goofr-group/jump-master/engine/internal/verifier"
+)
+
+// configVersion defines the configuration version of the routes in the tests.
+const configVersion = "test"
+
+func TestSolve(t *testing.T) {
+	// Draw a room with a ledge above the floor, and spawn the player on the left of the floor.
+	s := gametest.NewScenario(t,
+		"#     #",
+		"#     #",
+		"#     #",
+		"#     #",
+		"#     #",
+		"#     #",
+		"#   ###",
+		"#     #",
+		"#     #",
+		"#######",
+	)
+	s.Player.Object.Position = s.Standing(1, s.Map.Height-1)
+
+	solver := bot.New(s.Engine, s.Player, s.Map, configVersion, bot.Options{
+		BeamWidth:    4,
+		MaxJumps:     3,
+		ChargeLevels: 4,
+	})
+
+	r, err := solver.Solve()
+	if err != nil {
+		t.Fatalf("failed to solve the route: %v", err)
+	}
+
+	// The route reaches the ledge, and its claimed result is confirmed by simulating it from the spawn position.
+	ledge := s.Standing(4, 6)
+	if y := r.Result.Position.Y; y < ledge.Y-1 {
+		t.Errorf("expected the route to end on the ledge at %v, got %v", ledge.Y, y)
+	}
+
+	v := verifier.New(s.Engine, s.Player, s.Map, configVersion)
+	if _, err = v.Verify(r); err != nil {
+		t.Errorf("expected the route to be verified, got %v", err)
+	}
+}
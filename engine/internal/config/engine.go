@@ -2,10 +2,48 @@ package config
 
 import "github.com/goofr-group/go-math/vector2"
 
+// Collision detection modes of the player.
+const (
+	CollisionDetectionDiscrete = "discrete" // The collisions are only checked at the end of each physics update.
+	CollisionDetectionSwept    = "swept"    // The player bounds are swept against the static tiles before each physics update.
+)
+
 // Physics defines the structure of the physics configuration.
 type Physics struct {
-	UpdateRate float64         `json:"updateRate"` // Defines the physics update rate in seconds.
-	Gravity    vector2.Vector2 `json:"gravity"`    // Defines the gravity of the game world.
+	UpdateRate         float64         `json:"updateRate"`         // Defines the physics update rate in seconds.
+	Gravity            vector2.Vector2 `json:"gravity"`            // Defines the gravity of the game world.
+	CollisionDetection string          `json:"collisionDetection"` // Defines the collision detection mode of the player. Defaults to discrete.
+	MaxTimeStep        float64         `json:"maxTimeStep"`        // Defines the maximum elapsed time, in seconds, simulated in a single game step. If not defined, the elapsed time is not limited.
+}
+
+// Camera follow targets.
+const (
+	CameraFollowLeader = "leader" // The camera follows the highest player.
+	CameraFollowGroup  = "group"  // The camera follows the average position of the players.
+)
+
+// Camera modes.
+const (
+	CameraModeVertical = "vertical" // The camera snaps between screens on the y-axis and keeps its position on the x-axis.
+	CameraModeScreen   = "screen"   // The camera snaps between screens on both axes.
+	CameraModeFollow   = "follow"   // The camera smoothly follows the players with a dead zone and look-ahead.
+	CameraModeFixed    = "fixed"    // The camera keeps its initial position.
+)
+
+// Viewport policies used when the camera is resized.
+const (
+	ViewportLetterbox = "letterbox" // The whole screen is visible and scaled to fit inside the viewport.
+	ViewportFill      = "fill"      // The screen is scaled to fill the viewport, cropping the parts that do not fit.
+)
+
+// CameraShake defines the structure of the camera shake configuration. The shake is driven by a trauma value, in the
+// range [0; 1], that is added by the player events and decays over time.
+type CameraShake struct {
+	Enabled   bool               `json:"enabled"`   // Defines if the camera shakes. Can be disabled for accessibility.
+	MaxOffset vector2.Vector2    `json:"maxOffset"` // Defines the maximum offset of the camera, in world units, at full trauma.
+	Decay     float64            `json:"decay"`     // Defines the amount of trauma removed per second.
+	Seed      int64              `json:"seed"`      // Defines the seed of the shake randomness, so that replays shake the same way.
+	Trauma    map[string]float64 `json:"trauma"`    // Defines the trauma added per player event.
 }
 
 // Camera defines the structure of the camera configuration.
@@ -15,11 +53,53 @@ type Camera struct {
 	Height          float64         `json:"height"`          // Defines the height of the camera.
 	PPU             float64         `json:"ppu"`             // Defines pixels per game world unit.
 	TransitionSpeed float64         `json:"transitionSpeed"` // Defines the speed of the animation transition.
+	Follow          string          `json:"follow"`          // Defines which players the camera follows when there is more than one. Defaults to leader.
+	Mode            string          `json:"mode"`            // Defines how the camera follows the players. Defaults to vertical.
+	DeadZone        vector2.Vector2 `json:"deadZone"`        // Defines the size, in world units, of the area around the camera center where the players can move without moving the camera in follow mode.
+	LookAhead       vector2.Vector2 `json:"lookAhead"`       // Defines the distance, in world units, the camera looks ahead in the direction the players are moving in follow mode.
+	FollowSpeed     float64         `json:"followSpeed"`     // Defines how fast the camera catches up with the players in follow mode.
+	ClampToMap      bool            `json:"clampToMap"`      // Defines if the camera is kept inside the map bounds.
+	Shake           CameraShake     `json:"shake"`           // Defines the camera shake triggered by the player impacts.
+	Viewport        string          `json:"viewport"`        // Defines how the screen is scaled when the camera is resized. Defaults to letterbox.
+}
+
+// PlayerSlot defines the structure of the configuration of each player in the game.
+type PlayerSlot struct {
+	ActionPrefix string          `json:"actionPrefix"` // Defines the prefix of the player actions (e.g. "p1" for "p1.Jump"). An empty prefix uses the actions without a namespace.
+	Position     vector2.Vector2 `json:"position"`     // Defines the spawn position of the player.
+	Animations   Animations      `json:"animations"`   // Defines the animations of the player skin. If not defined, the animations of the player configuration are used.
+}
+
+// Ghost defines the structure of the ghost runs configuration.
+type Ghost struct {
+	Opacity float64 `json:"opacity"` // Defines the opacity, in the range [0; 1], used to render the ghost runs.
+}
+
+// Parallax repeat modes.
+const (
+	ParallaxRepeatNone = "none" // The layer image is drawn once.
+	ParallaxRepeatX    = "x"    // The layer image is repeated on the x-axis.
+	ParallaxRepeatY    = "y"    // The layer image is repeated on the y-axis.
+	ParallaxRepeatBoth = "both" // The layer image is repeated on both axes.
+)
+
+// ParallaxLayer defines the structure of the parallax layer configuration.
+type ParallaxLayer struct {
+	Image    string          `json:"image"`    // Defines the image of the layer.
+	Position vector2.Vector2 `json:"position"` // Defines the position of the top left corner of the layer image in the game world.
+	Size     vector2.Vector2 `json:"size"`     // Defines the size of the layer image in world units.
+	Scroll   vector2.Vector2 `json:"scroll"`   // Defines how much the layer moves with the camera on each axis, where 0 keeps the layer fixed on the screen and 1 moves it with the game world.
+	Repeat   string          `json:"repeat"`   // Defines on which axes the layer image is repeated. Defaults to none.
+	Order    int             `json:"order"`    // Defines the render order of the layer. Layers with a negative order are drawn behind the game objects, and the others in front of them.
 }
 
 // Engine defines the structure of the engine configuration.
 type Engine struct {
-	Physics     Physics           `json:"physics"`     // Defines the physics of the game engine.
-	Camera      Camera            `json:"camera"`      // Defines the camera of the game engine.
-	TileSprites map[string]string `json:"tileSprites"` // Defines the sprites of the map tileset per tile id.
+	Physics         Physics           `json:"physics"`         // Defines the physics of the game engine.
+	Camera          Camera            `json:"camera"`          // Defines the camera of the game engine.
+	TileSprites     map[string]string `json:"tileSprites"`     // Defines the sprites of the map tileset per tile id.
+	Players         []PlayerSlot      `json:"players"`         // Defines the players in the game. If not defined, a single player is spawned in the position of the player configuration.
+	PlayerCollision bool              `json:"playerCollision"` // Defines if the players collide with each other.
+	Ghost           Ghost             `json:"ghost"`           // Defines the ghost runs rendered alongside the players.
+	Parallax        []ParallaxLayer   `json:"parallax"`        // Defines the parallax background and foreground layers.
 }
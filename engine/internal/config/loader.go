@@ -1,7 +1,10 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/goofr-group/jump-master/engine"
@@ -16,9 +19,18 @@ const (
 	pathMapConfig = "configs/map.json"
 )
 
-// LoadEngine loads the engine configuration.
+// LoadEngine loads the engine configuration. Fails if the camera pixels per unit are not positive.
 func LoadEngine() (Engine, error) {
-	return loadConfig[Engine](pathEngineConfig)
+	config, err := loadConfig[Engine](pathEngineConfig)
+	if err != nil {
+		return config, err
+	}
+
+	if config.Camera.PPU <= 0 {
+		return config, errors.New("invalid camera pixels per unit")
+	}
+
+	return config, nil
 }
 
 // LoadPlayer loads the player configuration.
@@ -31,6 +43,23 @@ func LoadMap() (Map, error) {
 	return loadConfig[Map](pathMapConfig)
 }
 
+// Version returns the version of the configurations, computed as a hash of their contents. Runs simulated with
+// different configuration versions are not comparable.
+func Version() (string, error) {
+	hash := sha256.New()
+
+	for _, path := range []string{pathEngineConfig, pathPlayerConfig, pathMapConfig} {
+		data, err := engine.ConfigsFS.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+
+		hash.Write(data)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)[:8]), nil
+}
+
 // loadConfig returns the configuration in the specified path.
 func loadConfig[T any](path string) (T, error) {
 	var config T
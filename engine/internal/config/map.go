@@ -14,10 +14,30 @@ type Layer struct {
 	Collider bool   `json:"collider"` // Defines if the layer can collide with other dynamic objects in the world.
 }
 
+// GravityRegion defines the structure of the map gravity region configuration.
+type GravityRegion struct {
+	Name         string  `json:"name"`         // Defines the name of the region.
+	X            int     `json:"x"`            // Defines the position on the x-axis of the top left tile of the region on the map.
+	Y            int     `json:"y"`            // Defines the position on the y-axis of the top left tile of the region on the map.
+	Width        int     `json:"width"`        // Defines the width of the region in tiles.
+	Height       int     `json:"height"`       // Defines the height of the region in tiles.
+	GravityScale float64 `json:"gravityScale"` // Defines how much gravity affects the player inside the region.
+}
+
+// Goal defines the structure of the map goal configuration. A run is completed when the player reaches the goal area.
+type Goal struct {
+	X      int `json:"x"`      // Defines the position on the x-axis of the top left tile of the goal area on the map.
+	Y      int `json:"y"`      // Defines the position on the y-axis of the top left tile of the goal area on the map.
+	Width  int `json:"width"`  // Defines the width of the goal area in tiles.
+	Height int `json:"height"` // Defines the height of the goal area in tiles.
+}
+
 // Map defines the structure of the map configuration.
 type Map struct {
-	TileSize int     `json:"tileSize"`  // Defines the size of each tile.
-	Width    int     `json:"mapWidth"`  // Defines the width of the map.
-	Height   int     `json:"mapHeight"` // Defines the height of the map.
-	Layers   []Layer `json:"layers"`    // Defines the layers of the map.
+	TileSize       int             `json:"tileSize"`       // Defines the size of each tile.
+	Width          int             `json:"mapWidth"`       // Defines the width of the map.
+	Height         int             `json:"mapHeight"`      // Defines the height of the map.
+	Layers         []Layer         `json:"layers"`         // Defines the layers of the map.
+	GravityRegions []GravityRegion `json:"gravityRegions"` // Defines the regions of the map with a different gravity.
+	Goal           Goal            `json:"goal"`           // Defines the goal area of the map. Runs cannot be completed if the area is empty.
 }
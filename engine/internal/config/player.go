@@ -17,18 +17,48 @@ type Movement struct {
 	Speed float64 `json:"speed"` // Defines the movement speed.
 }
 
+// Jump charge curves.
+const (
+	ChargeCurveLinear  = "linear"  // The jump impulse grows linearly with the charge.
+	ChargeCurveEaseIn  = "easeIn"  // The jump impulse grows slowly at the beginning of the charge and faster at the end.
+	ChargeCurveEaseOut = "easeOut" // The jump impulse grows fast at the beginning of the charge and slower at the end.
+	ChargeCurveSteps   = "steps"   // The jump impulse grows in discrete charge levels.
+)
+
+// Jump angle models.
+const (
+	AngleModelScaled = "scaled" // The diagonal angle is scaled by the fraction of the maximum impulse.
+	AngleModelFixed  = "fixed"  // The diagonal angle is always the same.
+	AngleModelTable  = "table"  // The diagonal angle is interpolated from a table by the fraction of the maximum impulse.
+)
+
+// Jump overcharge behaviours.
+const (
+	OverchargeHold    = "hold"    // The jump is kept at the maximum impulse until the jump action is released.
+	OverchargeRelease = "release" // The jump is automatically released when the maximum impulse is reached.
+)
+
 // Jump defines the structure of the jump configuration.
 type Jump struct {
-	Impulse         float64 `json:"impulse"`         // Defines the base impulse of the jump to accumulate each second the jump action is performed.
-	MinImpulse      float64 `json:"minImpulse"`      // Defines the minimum impulse of the jump.
-	MaxImpulse      float64 `json:"maxImpulse"`      // Defines the maximum impulse of the jump.
-	DiagonalAngle   float64 `json:"diagonalAngle"`   // Defines the angle in degrees to apply when jumping left or right.
-	DirectionBuffer int     `json:"directionBuffer"` // Defines the length of the buffer of directions to be considered before and after the jump.
+	Impulse         float64   `json:"impulse"`         // Defines the base impulse of the jump to accumulate each second the jump action is performed.
+	MinImpulse      float64   `json:"minImpulse"`      // Defines the minimum impulse of the jump.
+	MaxImpulse      float64   `json:"maxImpulse"`      // Defines the maximum impulse of the jump.
+	DiagonalAngle   float64   `json:"diagonalAngle"`   // Defines the angle in degrees to apply when jumping left or right.
+	DirectionBuffer float64   `json:"directionBuffer"` // Defines the duration in seconds of the buffer of directions to be considered before and after the jump.
+	ChargeCurve     string    `json:"chargeCurve"`     // Defines the curve used to map the jump charge to the jump impulse. Defaults to linear.
+	ChargeLevels    int       `json:"chargeLevels"`    // Defines the number of charge levels when using the steps charge curve, from 1/N of the maximum impulse to the maximum impulse.
+	AngleModel      string    `json:"angleModel"`      // Defines the model used to compute the diagonal angle of the jump. Defaults to scaled.
+	AngleTable      []float64 `json:"angleTable"`      // Defines the diagonal angles in degrees, evenly distributed from no impulse to the maximum impulse, when using the table angle model.
+	Overcharge      string    `json:"overcharge"`      // Defines the behaviour when the maximum impulse is reached. Defaults to hold.
 }
 
 // Fall defines the structure of the fall configuration.
 type Fall struct {
-	AllowedDuration float64 `json:"allowedDuration"` // Defines the amount of time possible to be in the air until it is considered a fall when touching the ground.
+	AllowedDuration  float64 `json:"allowedDuration"`  // Defines the amount of time possible to be in the air until it is considered a fall when touching the ground.
+	StunDuration     float64 `json:"stunDuration"`     // Defines the amount of time in seconds the input is locked after a fall.
+	StunScale        float64 `json:"stunScale"`        // Defines the amount of stun time in seconds to add per second of fall beyond the allowed duration.
+	MaxStunDuration  float64 `json:"maxStunDuration"`  // Defines the maximum amount of stun time in seconds. A value of zero disables the limit.
+	TerminalVelocity float64 `json:"terminalVelocity"` // Defines the maximum falling speed. A value of zero disables the limit.
 }
 
 // KnockBack defines the structure of the knock-back configuration.
@@ -37,6 +67,12 @@ type KnockBack struct {
 	DiagonalAngle float64 `json:"diagonalAngle"` // Defines the angle in degrees to apply when there is a knock-back.
 }
 
+// Ceiling defines the structure of the ceiling hit configuration.
+type Ceiling struct {
+	VerticalDamping   float64 `json:"verticalDamping"`   // Defines the fraction, in the range [0; 1], of the upward velocity that is absorbed when hitting the ceiling. The remaining velocity is reflected downwards.
+	HorizontalDamping float64 `json:"horizontalDamping"` // Defines the fraction, in the range [0; 1], of the horizontal velocity that is absorbed when hitting the ceiling.
+}
+
 // Animator defines the structure of the animator configuration.
 type Animator struct {
 	Repeat   bool     `json:"repeat"`   // Defines if the frames should loop.
@@ -54,5 +90,6 @@ type Player struct {
 	Jump       Jump       `json:"jump"`       // Jump behaviour configurations.
 	Fall       Fall       `json:"fall"`       // Fall behaviour configurations.
 	KnockBack  KnockBack  `json:"knockBack"`  // Knock-back behaviour configurations.
+	Ceiling    Ceiling    `json:"ceiling"`    // Ceiling hit behaviour configurations.
 	Animations Animations `json:"animations"` // Animation configurations.
 }
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"github.com/goofr-group/go-math/vector2"
+)
+
+// DebugState defines the internal state of the behaviours and physics of the game world, used to diagnose the game.
+type DebugState struct {
+	Players []PlayerDebug `json:"players"`
+	Camera  CameraDebug   `json:"camera"`
+}
+
+// PlayerDebug defines the internal state of the behaviours of a player.
+type PlayerDebug struct {
+	ID                 int64            `json:"id"`
+	Grounds            []int64          `json:"grounds"`            // Defines the identifiers of the ground objects in contact with the player.
+	Ceilings           []int64          `json:"ceilings"`           // Defines the identifiers of the ceiling objects in contact with the player.
+	KnockBackPlatforms []int64          `json:"knockBackPlatforms"` // Defines the identifiers of the platform objects in contact with the player body.
+	Jump               JumpDebug        `json:"jump"`
+	Fall               FallDebug        `json:"fall"`
+	Animation          AnimationDebug   `json:"animation"`
+	Collisions         []CollisionDebug `json:"collisions"` // Defines the recent collisions of the player, from the oldest to the newest.
+}
+
+// JumpDebug defines the internal state of the jump behaviour.
+type JumpDebug struct {
+	CanJump            bool     `json:"canJump"`
+	Charge             float64  `json:"charge"`
+	AccumulatedImpulse float64  `json:"accumulatedImpulse"`
+	UsedImpulse        float64  `json:"usedImpulse"`
+	Overcharged        bool     `json:"overcharged"`
+	BufferBeforeJump   []string `json:"bufferBeforeJump"`
+	BufferAfterJump    []string `json:"bufferAfterJump"`
+}
+
+// FallDebug defines the internal state of the fall behaviour.
+type FallDebug struct {
+	Timer     float64 `json:"timer"`
+	StunTimer float64 `json:"stunTimer"`
+}
+
+// AnimationDebug defines the internal state of the animator behaviour.
+type AnimationDebug struct {
+	Name  string  `json:"name"`
+	Frame int     `json:"frame"`
+	Timer float64 `json:"timer"`
+}
+
+// CollisionDebug defines a collision of a player with another object.
+type CollisionDebug struct {
+	PlayerID      int64             `json:"playerId"`
+	Step          int               `json:"step"` // Defines the physics step, since the start of the run, in which the collision occurred.
+	OtherID       int64             `json:"otherId"`
+	OtherTag      string            `json:"otherTag"`
+	Enter         bool              `json:"enter"` // Defines if the collision started or ended.
+	ContactPoints []vector2.Vector2 `json:"contactPoints"`
+	Normal        vector2.Vector2   `json:"normal"` // Defines the normal of the surface of the other object, from the collision manifold.
+}
+
+// DebugStep defines the result of advancing the game world with the frame debugger.
+type DebugStep struct {
+	GameState  GameState        `json:"gameState"`
+	Collisions []CollisionDebug `json:"collisions"` // Defines the collisions of the players that occurred while advancing the game world.
+}
+
+// CameraDebug defines the internal state of the camera controller behaviour.
+type CameraDebug struct {
+	Mode             string          `json:"mode"`
+	Level            int             `json:"level"`
+	Transition       float64         `json:"transition"`
+	PreviousPosition vector2.Vector2 `json:"previousPosition"`
+	CurrentPosition  vector2.Vector2 `json:"currentPosition"`
+	Trauma           float64         `json:"trauma"`
+	ShakeOffset      vector2.Vector2 `json:"shakeOffset"`
+}
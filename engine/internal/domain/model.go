@@ -2,6 +2,7 @@ package domain
 
 import (
 	"github.com/goofr-group/game-engine/pkg/rendering"
+	"github.com/goofr-group/go-math/vector2"
 	"github.com/goofr-group/physics-engine/pkg/game"
 )
 
@@ -9,4 +10,45 @@ import (
 type GameState struct {
 	GameObjects []game.Object    `json:"gameObjects"`
 	Camera      rendering.Camera `json:"camera"`
+	Parallax    []ParallaxLayer  `json:"parallax"`
+	Paused      bool             `json:"paused"`
+	Alpha       float64          `json:"alpha"`      // Defines the fraction of the next physics update that has elapsed, used to interpolate the rendered positions.
+	Practice    bool             `json:"practice"`   // Defines if the practice mode is enabled.
+	Recordable  bool             `json:"recordable"` // Defines if the current run can be submitted to the records, which is not the case once the practice mode was enabled or the map was edited, or with multiple players.
+}
+
+// ParallaxLayer defines the state of a parallax layer in screen space.
+type ParallaxLayer struct {
+	Image    string          `json:"image"`
+	Position vector2.Vector2 `json:"position"` // Defines the position of the top left corner of the layer image in pixels. When repeated, it is the position of the first image that is visible on the screen.
+	Size     vector2.Vector2 `json:"size"`     // Defines the size of the layer image in pixels.
+	Repeat   string          `json:"repeat"`
+	Order    int             `json:"order"`
+}
+
+// Trajectory defines the predicted arc of a jump in world space.
+type Trajectory struct {
+	Points     []vector2.Vector2 `json:"points"`     // Defines the positions of the player after each physics update of the jump.
+	Landing    vector2.Vector2   `json:"landing"`    // Defines the position where the player lands.
+	Landed     bool              `json:"landed"`     // Defines if the player lands within the simulated duration.
+	Ticks      int               `json:"ticks"`      // Defines the number of physics updates until the player lands.
+	KnockBacks int               `json:"knockBacks"` // Defines the number of times the player bounces off a wall.
+}
+
+// PlayerState defines the state of a player in world space.
+type PlayerState struct {
+	Position         vector2.Vector2 `json:"position"`
+	Velocity         vector2.Vector2 `json:"velocity"`
+	Image            string          `json:"image"`
+	FlipHorizontally bool            `json:"flipHorizontally"`
+	Events           []string        `json:"events"` // Defines the events of the player that occurred in the last game step.
+}
+
+// Opponent defines the state of an opponent of a race in world space, rendered as a ghost.
+type Opponent struct {
+	ID               int             `json:"id"`
+	Name             string          `json:"name"`
+	Position         vector2.Vector2 `json:"position"`
+	Image            string          `json:"image"`
+	FlipHorizontally bool            `json:"flipHorizontally"`
 }
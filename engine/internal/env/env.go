@@ -0,0 +1,219 @@
+// Package env provides a reinforcement learning environment around the game, where each step advances the game world
+// deterministically by a fixed number of physics steps, faster than real time.
+package env
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/analysis"
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+)
+
+// spawnOffset defines the distance, in world units, above a surface where the player spawns, so that the player falls
+// onto it instead of starting inside it.
+const spawnOffset = 1
+
+// ObservationConfig defines which information of the game world is observed.
+type ObservationConfig struct {
+	Position   bool `json:"position"`   // Defines if the player position is observed.
+	Velocity   bool `json:"velocity"`   // Defines if the player velocity is observed.
+	Grounded   bool `json:"grounded"`   // Defines if the player ground contact is observed.
+	Charge     bool `json:"charge"`     // Defines if the jump charge is observed.
+	GridRadius int  `json:"gridRadius"` // Defines the radius, in tiles, of the collision grid observed around the player. The grid is not observed if 0.
+}
+
+// Options defines the structure of the environment options.
+type Options struct {
+	Observation  ObservationConfig // Defines which information of the game world is observed.
+	FrameSkip    int               // Defines the number of physics steps the action is held for in each step. Defaults to 1.
+	MaxSteps     int               // Defines the maximum number of steps of an episode. The episodes are not limited if 0.
+	TargetHeight float64           // Defines the position on the y-axis that ends the episode when reached. The episodes do not end by height if 0.
+	RandomSpawn  bool              // Defines if the player spawns on a random surface of the map, chosen with the seed of the episode.
+}
+
+// Action defines the state of the player actions held during a step.
+type Action struct {
+	Left  bool `json:"left"`
+	Right bool `json:"right"`
+	Jump  bool `json:"jump"`
+}
+
+// Observation defines the observed state of the game world. Only the configured information is defined.
+type Observation struct {
+	Position *vector2.Vector2 `json:"position,omitempty"`
+	Velocity *vector2.Vector2 `json:"velocity,omitempty"`
+	Grounded *bool            `json:"grounded,omitempty"`
+	Charge   *float64         `json:"charge,omitempty"` // Defines the jump charge, in the range [0; 1].
+	// Grid defines the colliding tiles around the player, from left to right and top to bottom, where 1 is a colliding
+	// tile or outside the map and 0 is empty. The player is in the center of the grid.
+	Grid []int `json:"grid,omitempty"`
+}
+
+// Env defines the structure of the environment.
+type Env struct {
+	engineConfig config.Engine
+	playerConfig config.Player
+	mapConfig    config.Map
+	options      Options
+
+	app       *app.App
+	solid     map[[2]int]bool // Defines the colliding tiles of the map by position.
+	steps     int             // Defines the number of steps of the current episode.
+	maxHeight float64         // Defines the highest position on the y-axis reached in the current episode.
+}
+
+// New returns a new environment for the given configurations. The game world has a single player, controlled with the
+// actions without a namespace. Reset must be called before the first step.
+func New(engineConfig config.Engine, playerConfig config.Player, mapConfig config.Map, options Options) *Env {
+	engineConfig.Players = nil
+	options.FrameSkip = max(options.FrameSkip, 1)
+
+	solid := make(map[[2]int]bool)
+	for _, layer := range mapConfig.Layers {
+		if !layer.Collider {
+			continue
+		}
+
+		for _, tile := range layer.Tiles {
+			solid[[2]int{tile.X, tile.Y}] = true
+		}
+	}
+
+	return &Env{
+		engineConfig: engineConfig,
+		playerConfig: playerConfig,
+		mapConfig:    mapConfig,
+		options:      options,
+		solid:        solid,
+	}
+}
+
+// Reset starts a new episode with the given seed and returns the initial observation. The seed defines the camera
+// shake and, if enabled, the spawn surface. Episodes with the same seed and actions are identical.
+func (e *Env) Reset(seed int64) (Observation, error) {
+	engineConfig := e.engineConfig
+	engineConfig.Camera.Shake.Seed = seed
+
+	// Spawn the player on a random surface of the map.
+	if e.options.RandomSpawn {
+		surfaces := analysis.Surfaces(e.mapConfig, e.playerConfig)
+		if len(surfaces) == 0 {
+			return Observation{}, errors.New("no surface to spawn on")
+		}
+
+		surface := surfaces[rand.New(rand.NewSource(seed)).Intn(len(surfaces))]
+		engineConfig.Players = []config.PlayerSlot{{
+			Position: vector2.Vector2{
+				X: grid.TilePosition(e.mapConfig, surface.X+surface.Width/2, surface.Y).X,
+				Y: surface.Top - e.playerConfig.Object.ColliderOffset.Y + spawnOffset,
+			},
+		}}
+	}
+
+	e.app = app.New(engineConfig, e.playerConfig, e.mapConfig)
+	if err := e.app.StartGameWorld(); err != nil {
+		return Observation{}, fmt.Errorf("failed to start game world: %w", err)
+	}
+
+	e.steps = 0
+	e.maxHeight = e.app.Players()[0].Position.Y
+
+	return e.observe(), nil
+}
+
+// Step holds the given action for the configured number of physics steps, and returns the observation, the reward and
+// whether the episode is done. The reward is the increase, in tiles, of the highest position reached in the episode.
+func (e *Env) Step(a Action) (Observation, float64, bool, error) {
+	if e.app == nil {
+		return Observation{}, 0, false, errors.New("environment not reset")
+	}
+
+	actions := map[string]bool{
+		action.Left:  a.Left,
+		action.Right: a.Right,
+		action.Jump:  a.Jump,
+	}
+
+	for i := 0; i < e.options.FrameSkip; i++ {
+		if err := e.app.Step(actions, e.app.UpdateRate()); err != nil {
+			return Observation{}, 0, false, fmt.Errorf("failed to step the game world: %w", err)
+		}
+	}
+
+	e.steps++
+
+	// Reward the progress in height.
+	position := e.app.Players()[0].Position
+	var reward float64
+	if position.Y > e.maxHeight {
+		if e.mapConfig.TileSize > 0 {
+			reward = (position.Y - e.maxHeight) / float64(e.mapConfig.TileSize)
+		}
+		e.maxHeight = position.Y
+	}
+
+	done := (e.options.MaxSteps > 0 && e.steps >= e.options.MaxSteps) ||
+		(e.options.TargetHeight != 0 && position.Y >= e.options.TargetHeight)
+
+	return e.observe(), reward, done, nil
+}
+
+// observe returns the configured observation of the current state of the game world.
+func (e *Env) observe() Observation {
+	var observation Observation
+
+	player := e.app.Players()[0]
+	debug := e.app.Debug().Players[0]
+	observed := e.options.Observation
+
+	if observed.Position {
+		observation.Position = &player.Position
+	}
+	if observed.Velocity {
+		observation.Velocity = &player.Velocity
+	}
+	if observed.Grounded {
+		grounded := len(debug.Grounds) > 0
+		observation.Grounded = &grounded
+	}
+	if observed.Charge {
+		observation.Charge = &debug.Jump.Charge
+	}
+	if observed.GridRadius > 0 {
+		observation.Grid = e.grid(player.Position, observed.GridRadius)
+	}
+
+	return observation
+}
+
+// grid returns the colliding tiles in the given radius around the given position.
+func (e *Env) grid(position vector2.Vector2, radius int) []int {
+	// Get the map coordinates of the tile in the given position. The map is defined from top to bottom, while the game
+	// world y-axis points upwards.
+	tileSize := float64(max(e.mapConfig.TileSize, 1))
+	centerX := int(math.Round(position.X / tileSize))
+	centerY := e.mapConfig.Height - 1 - int(math.Round(position.Y/tileSize))
+
+	size := 2*radius + 1
+	grid := make([]int, 0, size*size)
+	for y := centerY - radius; y <= centerY+radius; y++ {
+		for x := centerX - radius; x <= centerX+radius; x++ {
+			outside := x < 0 || x >= e.mapConfig.Width || y < 0 || y >= e.mapConfig.Height
+			if outside || e.solid[[2]int{x, y}] {
+				grid = append(grid, 1)
+			} else {
+				grid = append(grid, 0)
+			}
+		}
+	}
+
+	return grid
+}
@@ -5,3 +5,48 @@ const (
 	Right = "Right" // Represents the action to move right.
 	Jump  = "Jump"  // Represents the action to jump.
 )
+
+// separator defines the separator between the action prefix and the action name.
+const separator = "."
+
+// Set defines the structure of the names of the actions of a player.
+type Set struct {
+	Left  string // Represents the name of the action to move left.
+	Right string // Represents the name of the action to move right.
+	Jump  string // Represents the name of the action to jump.
+}
+
+// NewSet returns the names of the actions of a player with the given prefix (e.g. "p1.Jump"). An empty prefix returns
+// the actions without a namespace.
+func NewSet(prefix string) Set {
+	return Set{
+		Left:  Name(prefix, Left),
+		Right: Name(prefix, Right),
+		Jump:  Name(prefix, Jump),
+	}
+}
+
+// States returns the state of every action without a namespace, where the given actions are held and the others are
+// released.
+func States(held ...string) map[string]bool {
+	states := map[string]bool{
+		Left:  false,
+		Right: false,
+		Jump:  false,
+	}
+
+	for _, a := range held {
+		states[a] = true
+	}
+
+	return states
+}
+
+// Name returns the name of the action with the given prefix.
+func Name(prefix, action string) string {
+	if len(prefix) == 0 {
+		return action
+	}
+
+	return prefix + separator + action
+}
@@ -8,4 +8,5 @@ const (
 	JumpFall  = "jumpFall"  // Represents the player jump fall animation.
 	KnockBack = "knockBack" // Represents the player knock-back animation.
 	Fall      = "fall"      // Represents the player fall animation.
+	Bonk      = "bonk"      // Represents the player ceiling hit animation.
 )
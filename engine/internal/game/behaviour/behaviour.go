@@ -15,3 +15,13 @@ const (
 func easeOutSine(t float64) float64 {
 	return math.Sin(t * math.Pi / 2)
 }
+
+// easeInQuad calculates the easing in quadratic function for a given amount t in the bounds of 0 and 1.
+func easeInQuad(t float64) float64 {
+	return t * t
+}
+
+// easeOutQuad calculates the easing out quadratic function for a given amount t in the bounds of 0 and 1.
+func easeOutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
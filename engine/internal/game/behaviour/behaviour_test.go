@@ -0,0 +1,58 @@
+package behaviour_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+// loadConfigs returns the engine and player configurations of the game. Fails the test if they cannot be loaded.
+func loadConfigs(t *testing.T) (config.Engine, config.Player) {
+	t.Helper()
+
+	engineConfig, playerConfig, _ := gametest.Configs(t)
+
+	return engineConfig, playerConfig
+}
+
+// tallMap returns a map with the given number of rows, empty except for the given bottom row.
+func tallMap(rows int, bottom string) config.Map {
+	return gametest.Map(48, gametest.Floor(rows, bottom)...)
+}
+
+// dropPosition returns the position of the player in the top row of the given map, centered on the given column.
+func dropPosition(m config.Map, column int) vector2.Vector2 {
+	return gametest.Scenario{Map: m}.Drop(column)
+}
+
+// landedWorld starts a world with the given map and options, with the player dropped on the given column of the map,
+// and waits for the player to land. The map must be short enough for the player to land without being stunned.
+func landedWorld(t *testing.T, m config.Map, column int, options ...gametest.Option) *gametest.World {
+	t.Helper()
+
+	_, playerConfig := loadConfigs(t)
+	playerConfig.Object.Position = dropPosition(m, column)
+
+	options = append(options, gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+	w := gametest.New(t, options...)
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+
+	return w
+}
+
+// peak steps the world for the given number of physics steps while every action is released, and returns the highest
+// position of the player on the y-axis in those steps.
+func peak(w *gametest.World, ticks int) float64 {
+	highest := w.Player().Position.Y
+	for i := 0; i < ticks; i++ {
+		w.Wait(1)
+		highest = math.Max(highest, w.Player().Position.Y)
+	}
+
+	return highest
+}
@@ -0,0 +1,93 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/go-math/mathf"
+	"github.com/goofr-group/go-math/vector2"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
+	"github.com/goofr-group/jump-master/engine/internal/game/sound"
+)
+
+// Bonk defines the structure of the ceiling hit behaviour.
+type Bonk struct {
+	object *game.Object
+	config config.Ceiling
+
+	checkCeiling    *CheckCeiling
+	animator        *Animator
+	soundController *SoundController
+	eventController *EventController
+
+	// touchingCeiling defines if the object was in contact with the ceiling in the previous physics update. Used to
+	// only respond to the moment the object hits the ceiling.
+	touchingCeiling bool
+	// previousVelocity defines the velocity value from the previous physics update. Used to know how fast the object
+	// was moving before hitting the ceiling.
+	previousVelocity vector2.Vector2
+}
+
+// NewBonk returns a new ceiling hit behaviour with the given configuration.
+func NewBonk(
+	object *game.Object,
+	config config.Ceiling,
+	checkCeiling *CheckCeiling,
+	animator *Animator,
+	soundController *SoundController,
+	eventController *EventController,
+) Bonk {
+	return Bonk{
+		object:          object,
+		config:          config,
+		checkCeiling:    checkCeiling,
+		animator:        animator,
+		soundController: soundController,
+		eventController: eventController,
+	}
+}
+
+func (b Bonk) Enabled() bool {
+	return true
+}
+
+func (b *Bonk) FixedUpdate(_ *engine.Engine) error {
+	// Check if the rigid body is accessible.
+	if b.object == nil {
+		return nil
+	}
+	if b.object.RigidBody == nil {
+		return nil
+	}
+
+	// Check if the object has just hit the ceiling while moving upwards.
+	touchingCeiling := b.checkCeiling.TouchingCeiling()
+	if touchingCeiling && !b.touchingCeiling && b.previousVelocity.Y > Epsilon {
+		verticalDamping := mathf.Clamp(b.config.VerticalDamping, 0, 1)
+		horizontalDamping := mathf.Clamp(b.config.HorizontalDamping, 0, 1)
+
+		// Cancel the upward velocity, reflecting downwards what is not absorbed, and damp the horizontal velocity.
+		b.object.RigidBody.Velocity = vector2.Vector2{
+			X: b.previousVelocity.X * (1 - horizontalDamping),
+			Y: -b.previousVelocity.Y * (1 - verticalDamping),
+		}
+
+		b.animator.SetAnimation(animation.Bonk)
+		b.soundController.AddPlayerSound(sound.Bonk)
+		b.eventController.AddPlayerEvent(event.Bonk)
+	}
+
+	// Update the previous state.
+	b.touchingCeiling = touchingCeiling
+	b.previousVelocity = b.object.RigidBody.Velocity
+
+	return nil
+}
+
+// reset discards the velocity of the previous physics update, so that a ceiling hit is not computed from the movement
+// before the object is moved (e.g. teleported).
+func (b *Bonk) reset() {
+	b.previousVelocity = vector2.Vector2{}
+}
@@ -1,44 +1,71 @@
 package behaviour
 
 import (
+	"math"
+	"math/rand"
+
 	"github.com/goofr-group/game-engine/pkg/engine"
 	"github.com/goofr-group/game-engine/pkg/rendering"
 	"github.com/goofr-group/go-math/mathf"
 	"github.com/goofr-group/go-math/vector2"
 	"github.com/goofr-group/physics-engine/pkg/game"
 
-	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
 )
 
 // CameraController defines the structure of the camera controller behaviour.
 type CameraController struct {
 	camera *rendering.Camera
+	config config.Camera
 
-	playerObject    *game.Object    // Defines the player object.
+	playerObjects   []*game.Object  // Defines the player objects.
+	mapBounds       grid.Rect       // Defines the bounds of the map in the game world.
 	initialPosition vector2.Vector2 // Defines the camera initial position.
+	lastPosition    vector2.Vector2 // Defines the position of the camera before the last physics update.
 
 	previousPosition vector2.Vector2 // Defines the previous position of the camera.
 	currentPosition  vector2.Vector2 // Defines the current position of the camera. It represents the target position of the current level.
 
 	transition      float64 // Defines the current amount, in the range [0; 1], that has been transitioned from the previousPosition to the currentPosition.
 	transitionSpeed float64 // Defines the speed of the animation transition.
+
+	lookAhead          vector2.Vector2 // Defines the current look-ahead offset in follow mode.
+	lookAheadDirection vector2.Vector2 // Defines the last direction the players moved in on each axis.
+
+	shakeEnabled bool            // Defines if the camera shakes.
+	trauma       float64         // Defines the current trauma, in the range [0; 1], that drives the camera shake.
+	shakeOffset  vector2.Vector2 // Defines the current offset of the camera shake.
+	random       *rand.Rand      // Defines the seeded source of randomness of the camera shake.
 }
 
-// NewCameraController returns a new camera controller behaviour.
+// NewCameraController returns a new camera controller behaviour that follows the given players with the given
+// configuration. The map bounds are used to keep the camera inside the map, if configured.
 func NewCameraController(
 	camera *rendering.Camera,
-	cameraTransitionSpeed float64,
+	playerObjects []*game.Object,
+	config config.Camera,
+	mapBounds grid.Rect,
 ) CameraController {
 	// Check that the camera transition speed is valid.
-	if cameraTransitionSpeed <= 0 {
+	transitionSpeed := config.TransitionSpeed
+	if transitionSpeed <= 0 {
 		// Invalid speed, defaults to 1.
-		cameraTransitionSpeed = 1
+		transitionSpeed = 1
 	}
 
 	return CameraController{
 		camera: camera,
+		config: config,
 
-		transitionSpeed: cameraTransitionSpeed,
+		playerObjects:   playerObjects,
+		mapBounds:       mapBounds,
+		lastPosition:    camera.Position,
+		transitionSpeed: transitionSpeed,
+
+		shakeEnabled: config.Shake.Enabled,
+		random:       rand.New(rand.NewSource(config.Shake.Seed)),
 	}
 }
 
@@ -46,41 +73,71 @@ func (b CameraController) Enabled() bool {
 	return true
 }
 
-func (b *CameraController) Start(e *engine.Engine) error {
-	// Get the player object.
-	b.playerObject = e.World().FindGameObjectWithTag(tag.Player)
-
+func (b *CameraController) Start(_ *engine.Engine) error {
 	// Save the camera initial position.
 	b.initialPosition = b.camera.Position
 
 	// Initialize the previous and current positions.
+	b.lastPosition = b.initialPosition
 	b.previousPosition = b.initialPosition
 	b.currentPosition = b.initialPosition
 
 	return nil
 }
 
-func (b *CameraController) Update(e *engine.Engine) error {
-	// Check if the player object is accessible.
-	if b.playerObject == nil {
+func (b *CameraController) FixedUpdate(e *engine.Engine) error {
+	// The camera is updated in the physics updates, so that its movement and shake are the same at any display rate.
+	deltaTime := e.Time().FixedDeltaTime
+
+	// Record the position before the update, to interpolate the rendered position.
+	b.lastPosition = b.camera.Position
+
+	b.updateShake(deltaTime)
+
+	switch b.config.Mode {
+	case config.CameraModeFixed:
 		return nil
-	}
-	if b.playerObject.Collider == nil {
+
+	case config.CameraModeFollow:
+		b.updateFollow(deltaTime)
+		return nil
+
+	default:
+		b.updateScreen(deltaTime)
 		return nil
 	}
+}
 
-	// Get the player bounds.
-	playerBounds := b.playerObject.Collider.Bounds()
+// updateScreen snaps the camera to the screen the players are in, with an animation transition between screens. In
+// vertical mode, the camera only snaps on the y-axis.
+func (b *CameraController) updateScreen(deltaTime float64) {
+	// Get the bounds of the players being followed.
+	targetMin, targetMax, _, ok := b.target()
+	if !ok {
+		return
+	}
+
+	// The screens are measured with the configured size, so that they do not change when the viewport is resized.
+	screenSize := ScreenSize(b.config)
 
 	// Compute the camera position based on the player minimum bound.
-	level := int((playerBounds.Min.Y - b.initialPosition.Y + b.camera.PixelHeight*0.5) / b.camera.PixelHeight)
+	level := ScreenLevel(targetMin.Y, b.initialPosition.Y, screenSize.Y)
 
 	// newPosition represents the new position of the camera considering the current level of the player.
 	newPosition := vector2.Vector2{
 		X: b.camera.Position.X,
-		Y: b.camera.PixelHeight*float64(level) + b.initialPosition.Y,
+		Y: screenSize.Y*float64(level) + b.initialPosition.Y,
+	}
+
+	if b.config.Mode == config.CameraModeScreen {
+		// Compute the camera position based on the player center on the x-axis.
+		centerX := (targetMin.X + targetMax.X) / 2
+		column := int(math.Floor((centerX - b.initialPosition.X + screenSize.X*0.5) / screenSize.X))
+		newPosition.X = screenSize.X*float64(column) + b.initialPosition.X
 	}
 
+	newPosition = b.clamp(newPosition)
+
 	// Check whether the camera position has changed.
 	if newPosition != b.currentPosition {
 		// Update the previous and current positions.
@@ -93,14 +150,222 @@ func (b *CameraController) Update(e *engine.Engine) error {
 
 	// If the transition has been completed, avoid unnecessary computation.
 	if b.transition >= 1 {
-		return nil
+		return
 	}
 
 	// Compute the new animation transition.
-	b.transition = mathf.Clamp(b.transition+e.Time().DeltaTime*b.transitionSpeed, 0, 1)
+	b.transition = mathf.Clamp(b.transition+deltaTime*b.transitionSpeed, 0, 1)
 
 	// Apply the current transition using an easing function.
 	b.camera.Position = vector2.Lerp(b.previousPosition, b.currentPosition, easeOutSine(b.transition))
+}
 
-	return nil
+// updateFollow smoothly moves the camera towards the players, ignoring the movements inside the dead zone and looking
+// ahead in the direction the players are moving.
+func (b *CameraController) updateFollow(deltaTime float64) {
+	// Get the bounds and velocity of the players being followed.
+	targetMin, targetMax, velocity, ok := b.target()
+	if !ok {
+		return
+	}
+
+	// Exponential smoothing, independent of the frame rate.
+	followSpeed := b.config.FollowSpeed
+	if followSpeed <= 0 {
+		followSpeed = 1
+	}
+	smoothing := 1 - math.Exp(-followSpeed*deltaTime)
+
+	// Update the direction the players are moving in. On the x-axis, the last direction is kept while the players are
+	// standing still.
+	if velocity.X > Epsilon {
+		b.lookAheadDirection.X = 1
+	} else if velocity.X < -Epsilon {
+		b.lookAheadDirection.X = -1
+	}
+	b.lookAheadDirection.Y = 0
+	if velocity.Y > Epsilon {
+		b.lookAheadDirection.Y = 1
+	} else if velocity.Y < -Epsilon {
+		b.lookAheadDirection.Y = -1
+	}
+
+	lookAhead := vector2.Vector2{
+		X: b.lookAheadDirection.X * b.config.LookAhead.X,
+		Y: b.lookAheadDirection.Y * b.config.LookAhead.Y,
+	}
+	b.lookAhead = vector2.Lerp(b.lookAhead, lookAhead, smoothing)
+
+	// Compute the position the camera should be in, keeping the players inside the dead zone.
+	target := vector2.Vector2{
+		X: (targetMin.X+targetMax.X)/2 + b.lookAhead.X,
+		Y: (targetMin.Y+targetMax.Y)/2 + b.lookAhead.Y,
+	}
+	position := b.camera.Position
+	desiredPosition := vector2.Vector2{
+		X: followAxis(position.X, target.X, b.config.DeadZone.X/2),
+		Y: followAxis(position.Y, target.Y, b.config.DeadZone.Y/2),
+	}
+
+	b.camera.Position = b.clamp(vector2.Lerp(position, desiredPosition, smoothing))
+}
+
+// updateShake adds the trauma of the player events and updates the camera shake offset. The offset grows with the
+// square of the trauma, so that small impacts barely shake the camera. The random offsets are drawn once per physics
+// update, so that the shake of a run is reproducible from the seed.
+func (b *CameraController) updateShake(deltaTime float64) {
+	// Add the trauma of the events of the players in the last physics update.
+	for _, playerObject := range b.playerObjects {
+		if playerObject == nil {
+			continue
+		}
+
+		events, _ := playerObject.Property(property.Events).([]string)
+		for _, event := range events {
+			b.trauma += b.config.Shake.Trauma[event]
+		}
+	}
+	b.trauma = mathf.Clamp(b.trauma, 0, 1)
+
+	// Compute the shake offset.
+	b.shakeOffset = vector2.Vector2{}
+	if b.shakeEnabled && b.trauma > 0 {
+		shake := b.trauma * b.trauma
+		b.shakeOffset = vector2.Vector2{
+			X: b.config.Shake.MaxOffset.X * shake * (b.random.Float64()*2 - 1),
+			Y: b.config.Shake.MaxOffset.Y * shake * (b.random.Float64()*2 - 1),
+		}
+	}
+
+	// Decay the trauma over time.
+	b.trauma = math.Max(b.trauma-b.config.Shake.Decay*deltaTime, 0)
+}
+
+// Position returns the position of the camera interpolated between the last two physics updates, where an alpha of 0
+// is the position before the last physics update and 1 is the current position.
+func (b CameraController) Position(alpha float64) vector2.Vector2 {
+	return vector2.Lerp(b.lastPosition, b.camera.Position, alpha)
+}
+
+// ShakeOffset returns the current offset of the camera shake, to be applied to the camera position when rendering.
+func (b CameraController) ShakeOffset() vector2.Vector2 {
+	return b.shakeOffset
+}
+
+// SetShakeEnabled enables or disables the camera shake.
+func (b *CameraController) SetShakeEnabled(enabled bool) {
+	b.shakeEnabled = enabled
+	if !enabled {
+		b.shakeOffset = vector2.Vector2{}
+	}
+}
+
+// followAxis returns the position on an axis the camera should be in, so that the target is at most the given distance
+// away from it.
+func followAxis(position, target, halfDeadZone float64) float64 {
+	switch {
+	case target > position+halfDeadZone:
+		return target - halfDeadZone
+	case target < position-halfDeadZone:
+		return target + halfDeadZone
+	default:
+		return position
+	}
+}
+
+// clamp returns the given camera position, kept inside the map bounds if configured. When the map is smaller than the
+// view on an axis, the camera is centered on the map.
+func (b CameraController) clamp(position vector2.Vector2) vector2.Vector2 {
+	if !b.config.ClampToMap {
+		return position
+	}
+
+	halfViewSize := b.viewSize().Div(2)
+
+	return vector2.Vector2{
+		X: clampAxis(position.X, b.mapBounds.Min.X, b.mapBounds.Max.X, halfViewSize.X),
+		Y: clampAxis(position.Y, b.mapBounds.Min.Y, b.mapBounds.Max.Y, halfViewSize.Y),
+	}
+}
+
+// clampAxis returns the position on an axis, kept inside the given bounds with the given half view size.
+func clampAxis(position, min, max, halfViewSize float64) float64 {
+	if max-min <= halfViewSize*2 {
+		return (min + max) / 2
+	}
+
+	return mathf.Clamp(position, min+halfViewSize, max-halfViewSize)
+}
+
+// ScreenSize returns the size of a screen in world units, as defined in the camera configuration.
+func ScreenSize(config config.Camera) vector2.Vector2 {
+	return vector2.Vector2{
+		X: config.Width / config.PPU,
+		Y: config.Height / config.PPU,
+	}
+}
+
+// viewSize returns the size of the current camera view in world units.
+func (b CameraController) viewSize() vector2.Vector2 {
+	return vector2.Vector2{
+		X: b.camera.PixelWidth / b.camera.PixelsPerUnit,
+		Y: b.camera.PixelHeight / b.camera.PixelsPerUnit,
+	}
+}
+
+// ScreenLevel returns the screen level of the given minimum bound on the y-axis, for a camera with the given initial
+// position on the y-axis and screen height in world units.
+func ScreenLevel(minY, initialY, height float64) int {
+	return int((minY - initialY + height*0.5) / height)
+}
+
+// target returns the bounds and velocity of the players being followed. When following the leader, the highest
+// player is used, and when following the group, the average of the players is used. Returns false if no player is
+// accessible.
+func (b CameraController) target() (vector2.Vector2, vector2.Vector2, vector2.Vector2, bool) {
+	var targetMin, targetMax, velocity vector2.Vector2
+	var count int
+
+	for _, playerObject := range b.playerObjects {
+		// Check if the player object is accessible.
+		if playerObject == nil || playerObject.Collider == nil {
+			continue
+		}
+
+		// Get the player bounds and velocity.
+		playerBounds := playerObject.Collider.Bounds()
+		var playerVelocity vector2.Vector2
+		if playerObject.RigidBody != nil {
+			playerVelocity = playerObject.RigidBody.Velocity
+		}
+
+		switch b.config.Follow {
+		case config.CameraFollowGroup:
+			targetMin = vector2.Vector2{X: targetMin.X + playerBounds.Min.X, Y: targetMin.Y + playerBounds.Min.Y}
+			targetMax = vector2.Vector2{X: targetMax.X + playerBounds.Max.X, Y: targetMax.Y + playerBounds.Max.Y}
+			velocity = vector2.Vector2{X: velocity.X + playerVelocity.X, Y: velocity.Y + playerVelocity.Y}
+
+		default:
+			if count == 0 || playerBounds.Min.Y > targetMin.Y {
+				targetMin = playerBounds.Min
+				targetMax = playerBounds.Max
+				velocity = playerVelocity
+			}
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		return vector2.Vector2{}, vector2.Vector2{}, vector2.Vector2{}, false
+	}
+
+	if b.config.Follow == config.CameraFollowGroup {
+		n := float64(count)
+		targetMin = targetMin.Div(n)
+		targetMax = targetMax.Div(n)
+		velocity = velocity.Div(n)
+	}
+
+	return targetMin, targetMax, velocity, true
 }
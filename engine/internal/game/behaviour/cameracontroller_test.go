@@ -0,0 +1,121 @@
+package behaviour_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestCameraShakeFrameRateIndependence(t *testing.T) {
+	engineConfig, playerConfig := loadConfigs(t)
+
+	// Drop the player from high enough to be stunned, so that the landing shakes the camera.
+	m := tallMap(50, "#####")
+	playerConfig.Object.Position = dropPosition(m, 2)
+
+	// Sample the camera every third of a second, which is a multiple of the frame durations and the physics update
+	// rate.
+	const samples = 9
+	var expected []domain.CameraDebug
+
+	for _, fps := range []float64{30, 144} {
+		w := gametest.New(t, gametest.WithEngine(engineConfig), gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+
+		var shaken bool
+		for i := 0; i < samples; i++ {
+			for j := 0; j < int(math.Round(fps/3)); j++ {
+				if err := w.App().Step(action.States(), 1/fps); err != nil {
+					t.Fatalf("%v fps: failed to step frame: %v", fps, err)
+				}
+			}
+
+			_, camera := w.Debug()
+			shaken = shaken || camera.Trauma > 0
+
+			if len(expected) <= i {
+				expected = append(expected, camera)
+				continue
+			}
+
+			if camera.Trauma != expected[i].Trauma || camera.ShakeOffset != expected[i].ShakeOffset {
+				t.Errorf("%v fps, sample %d: expected trauma %v and shake %v, got %v and %v",
+					fps, i, expected[i].Trauma, expected[i].ShakeOffset, camera.Trauma, camera.ShakeOffset)
+			}
+		}
+
+		if !shaken {
+			t.Fatalf("%v fps: expected the landing to shake the camera", fps)
+		}
+	}
+}
+
+func TestCameraScreenTransition(t *testing.T) {
+	engineConfig, playerConfig := loadConfigs(t)
+	engineConfig.Camera.Mode = config.CameraModeVertical
+
+	// Drop the player from a few screens above the initial screen.
+	m := tallMap(50, "#####")
+	playerConfig.Object.Position = dropPosition(m, 2)
+
+	w := gametest.New(t, gametest.WithEngine(engineConfig), gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+	w.Wait(1)
+
+	_, camera := w.Debug()
+	if camera.Level <= 0 {
+		t.Fatalf("expected the player to start above the initial screen, got level %d", camera.Level)
+	}
+
+	// The camera transitions between the screens while the player falls.
+	var transitioning bool
+	for i := 0; i < w.Seconds(5); i++ {
+		w.Wait(1)
+
+		_, camera = w.Debug()
+		transitioning = transitioning || (camera.Transition > 0 && camera.Transition < 1)
+	}
+
+	if !transitioning {
+		t.Error("expected the camera to transition between the screens")
+	}
+
+	// The camera ends in the initial screen once the transition is completed.
+	w.AssertGrounded()
+	w.AssertCameraLevel(0)
+
+	_, camera = w.Debug()
+	if camera.Transition != 1 {
+		t.Errorf("expected the transition to be completed, got %v", camera.Transition)
+	}
+	if position := w.App().GameState().Camera.Position; math.Abs(position.Y-engineConfig.Camera.Position.Y) > 1e-6 {
+		t.Errorf("expected the camera in the initial position %v, got %v", engineConfig.Camera.Position, position)
+	}
+}
+
+func TestCameraFollow(t *testing.T) {
+	engineConfig, _ := loadConfigs(t)
+	engineConfig.Camera.Mode = config.CameraModeFollow
+	engineConfig.Camera.ClampToMap = false
+
+	w := landedWorld(t, tallMap(8, "########################################"), 5, gametest.WithEngine(engineConfig))
+	w.Wait(w.Seconds(3))
+	start := w.App().GameState().Camera.Position
+
+	// Walk to the right, and let the camera catch up once the player stops.
+	w.Hold(w.Seconds(2), action.Right)
+	w.Wait(w.Seconds(3))
+
+	camera := w.App().GameState().Camera.Position
+	if camera.X <= start.X {
+		t.Errorf("expected the camera to follow the player to the right of %v, got %v", start.X, camera.X)
+	}
+
+	// The camera keeps the player, looking ahead in the direction it walked, inside the dead zone.
+	target := w.Player().Position.X + engineConfig.Camera.LookAhead.X
+	if offset := math.Abs(target - camera.X); offset > engineConfig.Camera.DeadZone.X/2+1 {
+		t.Errorf("expected the camera within %v of %v, got %v", engineConfig.Camera.DeadZone.X/2, target, camera.X)
+	}
+}
@@ -56,15 +56,10 @@ func (b *CheckCeiling) OnTriggerEnter(e *engine.Engine, otherID int64) error {
 	return nil
 }
 
-func (b *CheckCeiling) OnTriggerExit(e *engine.Engine, otherID int64) error {
-	// Get the colliding object.
-	otherObject := e.World().GetGameObjectByID(otherID)
-	if otherObject == nil {
-		return nil
-	}
-
-	// Check if the colliding object contains the platform tag.
-	if otherObject.Tag != tag.Platform {
+func (b *CheckCeiling) OnTriggerExit(_ *engine.Engine, otherID int64) error {
+	// Check if the colliding object is a platform in contact with the object. The colliding object is not looked up,
+	// so that the contact is cleared even if it was removed from the game world.
+	if _, ok := b.ceilings[otherID]; !ok {
 		return nil
 	}
 
@@ -0,0 +1,110 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/go-math/vector2"
+	"github.com/goofr-group/physics-engine/pkg/collision"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// CollisionRecorder defines the structure of the behaviour that records the recent collisions of the object.
+type CollisionRecorder struct {
+	object *game.Object
+
+	capacity   int                     // Defines the maximum number of collisions recorded.
+	step       int                     // Defines the current physics step since the start of the run.
+	collisions []domain.CollisionDebug // Defines the recorded collisions, from the oldest to the newest.
+}
+
+// NewCollisionRecorder returns a new behaviour that records up to the given number of recent collisions.
+func NewCollisionRecorder(object *game.Object, capacity int) CollisionRecorder {
+	return CollisionRecorder{
+		object:   object,
+		capacity: capacity,
+	}
+}
+
+func (b CollisionRecorder) Enabled() bool {
+	return true
+}
+
+func (b *CollisionRecorder) FixedUpdate(_ *engine.Engine) error {
+	b.step++
+
+	return nil
+}
+
+func (b *CollisionRecorder) OnCollisionEnter(e *engine.Engine, otherID int64, manifold collision.Manifold) error {
+	b.record(e, otherID, manifold, true)
+
+	return nil
+}
+
+func (b *CollisionRecorder) OnCollisionExit(e *engine.Engine, otherID int64, manifold collision.Manifold) error {
+	b.record(e, otherID, manifold, false)
+
+	return nil
+}
+
+// Collisions returns the recent collisions of the object, from the oldest to the newest.
+func (b CollisionRecorder) Collisions() []domain.CollisionDebug {
+	return b.CollisionsSince(0)
+}
+
+// CollisionsSince returns the recent collisions of the object that occurred after the given physics step, from the
+// oldest to the newest.
+func (b CollisionRecorder) CollisionsSince(step int) []domain.CollisionDebug {
+	collisions := make([]domain.CollisionDebug, 0, len(b.collisions))
+	for _, c := range b.collisions {
+		if c.Step > step {
+			collisions = append(collisions, c)
+		}
+	}
+
+	return collisions
+}
+
+// Step returns the current physics step since the start of the run.
+func (b CollisionRecorder) Step() int {
+	return b.step
+}
+
+// record records the collision with the other object, discarding the oldest collision when the capacity is reached.
+func (b *CollisionRecorder) record(e *engine.Engine, otherID int64, manifold collision.Manifold, enter bool) {
+	if b.capacity <= 0 {
+		return
+	}
+
+	c := domain.CollisionDebug{
+		Step:    b.step,
+		OtherID: otherID,
+		Enter:   enter,
+		Normal:  normal(manifold),
+	}
+	if b.object != nil {
+		c.PlayerID = b.object.ID()
+	}
+
+	for _, cp := range manifold.ContactPoints {
+		c.ContactPoints = append(c.ContactPoints, cp.Position)
+	}
+
+	// Get the colliding object.
+	otherObject := e.World().GetGameObjectByID(otherID)
+	if otherObject != nil {
+		c.OtherTag = otherObject.Tag
+	}
+
+	if len(b.collisions) == b.capacity {
+		b.collisions = b.collisions[1:]
+	}
+	b.collisions = append(b.collisions, c)
+}
+
+// normal returns the normal of the surface of the other object in contact with the object. The normal of the
+// collision manifold points from the object to the other object, so it is reversed to point out of the other object.
+func normal(manifold collision.Manifold) vector2.Vector2 {
+	return manifold.Normal.Mul(-1)
+}
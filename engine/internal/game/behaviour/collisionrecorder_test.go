@@ -0,0 +1,63 @@
+package behaviour_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+// enterNormals returns the normals of the collisions the player entered after the given physics step.
+func enterNormals(w *gametest.World, since int) []vector2.Vector2 {
+	player, _ := w.Debug()
+
+	var normals []vector2.Vector2
+	for _, c := range player.Collisions {
+		if c.Enter && c.Step > since {
+			normals = append(normals, c.Normal)
+		}
+	}
+
+	return normals
+}
+
+func TestCollisionNormal(t *testing.T) {
+	// Draw a room with walls on both sides of the player.
+	rows := make([]string, 12)
+	for i := range rows {
+		rows[i] = "#" + strings.Repeat(" ", 5) + "#"
+	}
+	rows[len(rows)-1] = strings.Repeat("#", 7)
+	m := gametest.Map(48, rows...)
+
+	_, playerConfig := loadConfigs(t)
+	playerConfig.Object.Position = dropPosition(m, 3)
+
+	w := gametest.New(t, gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+
+	// The floor pushes the player up when it lands.
+	start := w.Ticks()
+	w.Wait(w.Seconds(1))
+	w.AssertGrounded()
+
+	if normals := enterNormals(w, start); len(normals) == 0 || normals[0] != vector2.Up() {
+		t.Errorf("expected the landing normal %v, got %v", vector2.Up(), normals)
+	}
+
+	// The wall on the right pushes the player to the left when it walks into it.
+	start = w.Ticks()
+	w.Hold(w.Seconds(1), action.Right)
+
+	left := vector2.Vector2{X: -1, Y: 0}
+	normals := enterNormals(w, start)
+	for _, normal := range normals {
+		if normal == left {
+			return
+		}
+	}
+
+	t.Errorf("expected the wall normal %v, got %v", left, normals)
+}
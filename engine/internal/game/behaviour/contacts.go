@@ -0,0 +1,60 @@
+package behaviour
+
+import "maps"
+
+// PlayerContacts defines the structure used to update the contacts of a player with the platforms, when the platforms
+// change outside of the collision events (e.g. removed from the game world).
+type PlayerContacts struct {
+	checkGround  *CheckGround
+	checkCeiling *CheckCeiling
+	knockBack    *KnockBack
+}
+
+// NewPlayerContacts returns a new contacts controller for the given player behaviours.
+func NewPlayerContacts(checkGround *CheckGround, checkCeiling *CheckCeiling, knockBack *KnockBack) PlayerContacts {
+	return PlayerContacts{
+		checkGround:  checkGround,
+		checkCeiling: checkCeiling,
+		knockBack:    knockBack,
+	}
+}
+
+// Remove discards the contacts of the player with the object with the given identifier, once the object is removed
+// from the game world, since the collision events of a removed object are not handled.
+func (c PlayerContacts) Remove(objectID int64) {
+	delete(c.checkGround.grounds, objectID)
+	delete(c.checkCeiling.ceilings, objectID)
+	delete(c.knockBack.platforms, objectID)
+}
+
+// reset discards every contact of the player, once it is moved away from the platforms outside of the physics
+// updates (e.g. teleported).
+func (c PlayerContacts) reset() {
+	clear(c.checkGround.grounds)
+	clear(c.checkCeiling.ceilings)
+	clear(c.knockBack.platforms)
+}
+
+// contacts defines the state of the contacts of a player, saved in a snapshot.
+type contacts struct {
+	grounds   map[int64]bool
+	ceilings  map[int64]bool
+	platforms map[int64]bool
+}
+
+// snapshot returns a copy of the current contacts of the player.
+func (c PlayerContacts) snapshot() contacts {
+	return contacts{
+		grounds:   maps.Clone(c.checkGround.grounds),
+		ceilings:  maps.Clone(c.checkCeiling.ceilings),
+		platforms: maps.Clone(c.knockBack.platforms),
+	}
+}
+
+// restore replaces the contacts of the player with a copy of the given contacts.
+func (c PlayerContacts) restore(saved contacts) {
+	c.reset()
+	maps.Copy(c.checkGround.grounds, saved.grounds)
+	maps.Copy(c.checkCeiling.ceilings, saved.ceilings)
+	maps.Copy(c.knockBack.platforms, saved.platforms)
+}
@@ -0,0 +1,124 @@
+package behaviour
+
+import (
+	"sort"
+
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+)
+
+// PlayerDebugger defines the structure used to inspect the internal state of the behaviours of a player.
+type PlayerDebugger struct {
+	object *game.Object
+
+	checkGround       *CheckGround
+	checkCeiling      *CheckCeiling
+	jump              *Jump
+	fall              *Fall
+	knockBack         *KnockBack
+	animator          *Animator
+	collisionRecorder *CollisionRecorder
+}
+
+// NewPlayerDebugger returns a new debugger for the given player behaviours.
+func NewPlayerDebugger(
+	object *game.Object,
+	checkGround *CheckGround,
+	checkCeiling *CheckCeiling,
+	jump *Jump,
+	fall *Fall,
+	knockBack *KnockBack,
+	animator *Animator,
+	collisionRecorder *CollisionRecorder,
+) PlayerDebugger {
+	return PlayerDebugger{
+		object:            object,
+		checkGround:       checkGround,
+		checkCeiling:      checkCeiling,
+		jump:              jump,
+		fall:              fall,
+		knockBack:         knockBack,
+		animator:          animator,
+		collisionRecorder: collisionRecorder,
+	}
+}
+
+// Debug returns the current internal state of the player behaviours.
+func (d PlayerDebugger) Debug() domain.PlayerDebug {
+	return domain.PlayerDebug{
+		ID:                 d.object.ID(),
+		Grounds:            contacts(d.checkGround.grounds),
+		Ceilings:           contacts(d.checkCeiling.ceilings),
+		KnockBackPlatforms: contacts(d.knockBack.platforms),
+		Jump: domain.JumpDebug{
+			CanJump:            d.jump.canJump,
+			Charge:             d.jump.charge,
+			AccumulatedImpulse: d.jump.accumulatedImpulse,
+			UsedImpulse:        d.jump.usedImpulse,
+			Overcharged:        d.jump.overcharged,
+			BufferBeforeJump:   append([]string(nil), d.jump.actionBufferBeforeJump...),
+			BufferAfterJump:    append([]string(nil), d.jump.actionBufferAfterJump...),
+		},
+		Fall: domain.FallDebug{
+			Timer:     d.fall.timer,
+			StunTimer: d.fall.stunTimer,
+		},
+		Animation: domain.AnimationDebug{
+			Name:  d.animator.currentAnimation,
+			Frame: d.animator.currentFrame,
+			Timer: d.animator.currentTimer,
+		},
+		Collisions: d.collisionRecorder.Collisions(),
+	}
+}
+
+// Step returns the current physics step of the player since the start of the run.
+func (d PlayerDebugger) Step() int {
+	return d.collisionRecorder.Step()
+}
+
+// CollisionsSince returns the recent collisions of the player that occurred after the given physics step.
+func (d PlayerDebugger) CollisionsSince(step int) []domain.CollisionDebug {
+	return d.collisionRecorder.CollisionsSince(step)
+}
+
+// Debug returns the current internal state of the camera controller.
+func (b CameraController) Debug() domain.CameraDebug {
+	mode := b.config.Mode
+	if len(mode) == 0 {
+		mode = config.CameraModeVertical
+	}
+
+	var level int
+	if targetMin, _, _, ok := b.target(); ok {
+		level = ScreenLevel(targetMin.Y, b.initialPosition.Y, ScreenSize(b.config).Y)
+	}
+
+	return domain.CameraDebug{
+		Mode:             mode,
+		Level:            level,
+		Transition:       b.transition,
+		PreviousPosition: b.previousPosition,
+		CurrentPosition:  b.currentPosition,
+		Trauma:           b.trauma,
+		ShakeOffset:      b.shakeOffset,
+	}
+}
+
+// contacts returns the sorted identifiers of the objects in contact from the given contact states.
+func contacts(states map[int64]bool) []int64 {
+	ids := make([]int64, 0, len(states))
+	for id, touching := range states {
+		if touching {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
@@ -0,0 +1,55 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+)
+
+// EventController defines the structure of the event controller behaviour.
+type EventController struct {
+	playerObject *game.Object        // Defines the player object.
+	playerEvents map[string]struct{} // Defines the current map of player events.
+}
+
+// NewEventController returns a new event controller behaviour.
+func NewEventController(
+	playerObject *game.Object,
+) EventController {
+	return EventController{
+		playerObject: playerObject,
+		playerEvents: make(map[string]struct{}),
+	}
+}
+
+func (b EventController) Enabled() bool {
+	return true
+}
+
+func (b *EventController) Update(_ *engine.Engine) error {
+	// Set the events property with the list of events for the current frame.
+	b.playerObject.SetProperty(property.Events, b.PlayerEvents())
+
+	// Reset the list of events for the next frame.
+	b.playerEvents = map[string]struct{}{}
+
+	return nil
+}
+
+// PlayerEvents returns the list of events associated with the player that occurred in the current frame.
+func (b EventController) PlayerEvents() []string {
+	events := make([]string, 0, len(b.playerEvents))
+
+	for event := range b.playerEvents {
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// AddPlayerEvent adds the given player event to the list.
+// The list of player events is cleared each frame.
+func (b *EventController) AddPlayerEvent(event string) {
+	b.playerEvents[event] = struct{}{}
+}
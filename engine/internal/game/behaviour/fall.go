@@ -1,40 +1,52 @@
 package behaviour
 
 import (
+	"math"
+
 	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/go-math/vector2"
 	"github.com/goofr-group/physics-engine/pkg/game"
 
 	"github.com/goofr-group/jump-master/engine/internal/config"
 	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
 	"github.com/goofr-group/jump-master/engine/internal/game/sound"
 )
 
 // Fall defines the structure of the fall behaviour.
 type Fall struct {
-	object *game.Object
-	config config.Fall
+	object  *game.Object
+	config  config.Fall
+	gravity vector2.Vector2 // Defines the gravity of the game world, added to the velocity in the next physics update.
 
 	checkGround     *CheckGround
 	animator        *Animator
 	soundController *SoundController
+	eventController *EventController
 
-	timer float64 // Defines the timer that captures the amount of time the object is falling.
+	timer     float64 // Defines the timer that captures the amount of time the object is falling.
+	stunTimer float64 // Defines the timer that captures the remaining amount of time the object is stunned.
 }
 
-// NewFall returns a new fall behaviour with the given configuration.
+// NewFall returns a new fall behaviour with the given configuration and gravity of the game world.
 func NewFall(
 	object *game.Object,
 	config config.Fall,
+	gravity vector2.Vector2,
 	checkGround *CheckGround,
 	animator *Animator,
 	soundController *SoundController,
+	eventController *EventController,
 ) Fall {
 	return Fall{
 		object:          object,
 		config:          config,
+		gravity:         gravity,
 		checkGround:     checkGround,
 		animator:        animator,
 		soundController: soundController,
+		eventController: eventController,
 	}
 }
 
@@ -42,7 +54,7 @@ func (b Fall) Enabled() bool {
 	return true
 }
 
-func (b *Fall) Update(e *engine.Engine) error {
+func (b *Fall) FixedUpdate(e *engine.Engine) error {
 	time := e.Time()
 
 	// Check if the rigid body is accessible.
@@ -53,18 +65,32 @@ func (b *Fall) Update(e *engine.Engine) error {
 		return nil
 	}
 
+	// Update the stun timer.
+	b.stunTimer = math.Max(b.stunTimer-time.FixedDeltaTime, 0)
+
+	// Limit the falling speed of the object, so that it does not exceed the terminal velocity once the gravity is added
+	// to the velocity in the next physics update.
+	limit := -b.config.TerminalVelocity - b.gravity.Y*b.object.RigidBody.GravityScale*time.FixedDeltaTime
+	terminalVelocity := b.config.TerminalVelocity > 0 && b.object.RigidBody.Velocity.Y <= limit
+	if terminalVelocity {
+		b.object.RigidBody.Velocity.Y = limit
+	}
+	b.object.SetProperty(property.TerminalVelocity, terminalVelocity)
+
 	// Check if the object is falling.
 	if b.object.RigidBody.Velocity.Y < -Epsilon && !b.checkGround.TouchingGround() {
 		// If it is falling, update the timer.
-		b.timer += time.DeltaTime
+		b.timer += time.FixedDeltaTime
 		return nil
 	}
 
 	// Check if the object was falling for longer than the allowed duration.
 	if b.timer > b.config.AllowedDuration {
+		b.stunTimer = b.stunDuration()
 		b.object.RigidBody.Velocity.X = 0
 		b.animator.SetAnimation(animation.Fall)
 		b.soundController.AddPlayerSound(sound.Fall)
+		b.eventController.AddPlayerEvent(event.Fall)
 	} else if b.timer > 0 && b.checkGround.TouchingGround() {
 		b.soundController.AddPlayerSound(sound.Landing)
 	}
@@ -74,3 +100,25 @@ func (b *Fall) Update(e *engine.Engine) error {
 
 	return nil
 }
+
+// Stunned returns true if the object is recovering from a fall and should not respond to input.
+func (b Fall) Stunned() bool {
+	return b.stunTimer > 0
+}
+
+// reset discards the fall and stun timers, so that a fall is not detected after the object is moved (e.g.
+// teleported).
+func (b *Fall) reset() {
+	b.timer = 0
+	b.stunTimer = 0
+}
+
+// stunDuration returns the stun duration for the current fall timer.
+func (b Fall) stunDuration() float64 {
+	duration := b.config.StunDuration + b.config.StunScale*(b.timer-b.config.AllowedDuration)
+	if b.config.MaxStunDuration > 0 {
+		duration = math.Min(duration, b.config.MaxStunDuration)
+	}
+
+	return duration
+}
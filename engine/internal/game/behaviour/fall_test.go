@@ -0,0 +1,73 @@
+package behaviour_test
+
+import (
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestFallStun(t *testing.T) {
+	engineConfig, playerConfig := loadConfigs(t)
+	fallConfig := playerConfig.Fall
+
+	// The falling speed is limited before the physics update with the gravity it adds, up to a rounding error.
+	limit := fallConfig.TerminalVelocity + 1e-9
+
+	// Drop the player from high enough to fall for longer than allowed.
+	m := tallMap(50, "#####")
+	playerConfig.Object.Position = dropPosition(m, 2)
+
+	w := gametest.New(t, gametest.WithEngine(engineConfig), gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+	start := w.Ticks()
+
+	// The falling speed is limited to the terminal velocity.
+	for i := 0; i < w.Seconds(5); i++ {
+		w.Wait(1)
+
+		if player, _ := w.Debug(); len(player.Grounds) > 0 {
+			break
+		}
+
+		if y := w.Player().Velocity.Y; y < -limit {
+			t.Fatalf("tick %d: expected the falling speed to be limited to %v, got %v", w.Ticks(), limit, -y)
+		}
+	}
+
+	// The landing stuns the player once it is detected in the next physics update.
+	w.Wait(1)
+	w.AssertGrounded()
+	w.AssertStunned()
+	w.AssertAnimation(animation.Fall)
+	w.ExpectEvent(event.Fall, start)
+
+	// The jump cannot be charged while stunned.
+	w.Hold(w.Seconds(fallConfig.StunDuration/2), action.Jump)
+	if player, _ := w.Debug(); player.Jump.Charge != 0 {
+		t.Errorf("expected no jump charge while stunned, got %v", player.Jump.Charge)
+	}
+
+	// The player recovers after the stun duration, and the jump action must be pressed again to charge.
+	w.Wait(w.Seconds(fallConfig.StunDuration))
+	if player, _ := w.Debug(); player.Fall.StunTimer != 0 {
+		t.Errorf("expected the player to recover, stun timer %v", player.Fall.StunTimer)
+	}
+
+	w.Hold(w.Seconds(0.1), action.Jump)
+	if player, _ := w.Debug(); player.Jump.Charge <= 0 {
+		t.Error("expected the jump to be charged after recovering")
+	}
+}
+
+func TestShortFall(t *testing.T) {
+	// The player lands on the floor of a short map without being stunned.
+	w := landedWorld(t, tallMap(8, floor), 7)
+	w.ExpectNoEvent(event.Fall, 0)
+	w.AssertAnimation(animation.Idle)
+
+	if player, _ := w.Debug(); player.Fall.StunTimer != 0 {
+		t.Errorf("expected the player not to be stunned, stun timer %v", player.Fall.StunTimer)
+	}
+}
@@ -0,0 +1,59 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// Ghost defines the structure of the ghost behaviour.
+type Ghost struct {
+	object *game.Object
+	trace  replay.Trace
+
+	runTimer *RunTimer
+	opacity  float64 // Defines the opacity used to render the ghost.
+}
+
+// NewGhost returns a new ghost behaviour that plays the given trace, aligned to the elapsed time of the run. The ghost
+// moves once per update pass, which follows each physics update, so that it can be interpolated in the same way as the
+// players.
+func NewGhost(
+	object *game.Object,
+	trace replay.Trace,
+	runTimer *RunTimer,
+	opacity float64,
+) Ghost {
+	return Ghost{
+		object:   object,
+		trace:    trace,
+		runTimer: runTimer,
+		opacity:  opacity,
+	}
+}
+
+func (b Ghost) Enabled() bool {
+	return true
+}
+
+func (b *Ghost) Update(_ *engine.Engine) error {
+	// Check if the object is accessible.
+	if b.object == nil {
+		return nil
+	}
+
+	// Get the frame of the trace at the elapsed time of the run.
+	frame, ok := b.trace.Frame(b.runTimer.Elapsed())
+	if !ok {
+		return nil
+	}
+
+	b.object.Transform.Position = frame.Position
+	b.object.SetProperty(property.Opacity, b.opacity)
+	b.object.SetProperty(property.Image, frame.Image)
+	b.object.SetProperty(property.FlipHorizontally, frame.FlipHorizontally)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+)
+
+// GravityRegion defines the structure of a region of the game world with a different gravity.
+type GravityRegion struct {
+	Name         string    // Defines the name of the region.
+	Bounds       grid.Rect // Defines the bounds of the region in the game world.
+	GravityScale float64   // Defines how much gravity affects the object inside the region.
+}
+
+// Gravity defines the structure of the behaviour to update the gravity of the object based on the region it is in.
+type Gravity struct {
+	object  *game.Object
+	regions []GravityRegion
+
+	gravityScale  float64 // Defines the gravity scale of the object outside any region.
+	currentRegion int     // Defines the index of the region the object is in, or -1 if it is outside any region.
+}
+
+// NewGravity returns a new gravity behaviour with the given regions.
+func NewGravity(
+	object *game.Object,
+	regions []GravityRegion,
+) Gravity {
+	gravityScale := 1.0
+	if object != nil && object.RigidBody != nil {
+		gravityScale = object.RigidBody.GravityScale
+	}
+
+	return Gravity{
+		object:  object,
+		regions: regions,
+
+		gravityScale:  gravityScale,
+		currentRegion: -1,
+	}
+}
+
+func (b Gravity) Enabled() bool {
+	return true
+}
+
+func (b *Gravity) FixedUpdate(_ *engine.Engine) error {
+	// Check if the rigid body is accessible.
+	if b.object == nil {
+		return nil
+	}
+	if b.object.RigidBody == nil {
+		return nil
+	}
+
+	// Find the region the object is in. The first region defined takes precedence over the following ones.
+	region := -1
+	for i, r := range b.regions {
+		if r.Bounds.Contains(b.object.Transform.Position) {
+			region = i
+			break
+		}
+	}
+
+	// Check if the object has entered or exited a region.
+	if region == b.currentRegion {
+		return nil
+	}
+	b.currentRegion = region
+
+	// Update the gravity scale of the object.
+	if region < 0 {
+		b.object.RigidBody.GravityScale = b.gravityScale
+		b.object.SetProperty(property.GravityRegion, nil)
+		return nil
+	}
+
+	b.object.RigidBody.GravityScale = b.regions[region].GravityScale
+	b.object.SetProperty(property.GravityRegion, b.regions[region].Name)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/go-math/vector2"
+	"github.com/goofr-group/physics-engine/pkg/game"
+)
+
+// Interpolator defines the structure of the interpolator behaviour, which records the position of the object before
+// each physics update, so that it can be rendered between the last two physics updates.
+type Interpolator struct {
+	object *game.Object
+
+	previous vector2.Vector2 // Defines the position of the object before the last physics update.
+	recorded bool            // Defines if the previous position has been recorded.
+}
+
+// NewInterpolator returns a new interpolator behaviour.
+func NewInterpolator(object *game.Object) Interpolator {
+	return Interpolator{
+		object: object,
+	}
+}
+
+func (b Interpolator) Enabled() bool {
+	return true
+}
+
+func (b *Interpolator) FixedUpdate(_ *engine.Engine) error {
+	// Check if the object is accessible.
+	if b.object == nil {
+		return nil
+	}
+
+	b.previous = b.object.Transform.Position
+	b.recorded = true
+
+	return nil
+}
+
+// Position returns the position of the object interpolated between the last two physics updates, where an alpha of 0
+// is the position before the last physics update and 1 is the current position.
+func (b Interpolator) Position(alpha float64) vector2.Vector2 {
+	// Check if the object is accessible.
+	if b.object == nil {
+		return vector2.Vector2{}
+	}
+
+	current := b.object.Transform.Position
+	if !b.recorded {
+		return current
+	}
+
+	return vector2.Vector2{
+		X: b.previous.X + (current.X-b.previous.X)*alpha,
+		Y: b.previous.Y + (current.Y-b.previous.Y)*alpha,
+	}
+}
+
+// Reset discards the previous position, so that the object is rendered in its current position until the next physics
+// update (e.g. after being teleported).
+func (b *Interpolator) Reset() {
+	b.recorded = false
+}
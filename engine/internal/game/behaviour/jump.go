@@ -20,42 +20,50 @@ import (
 type Jump struct {
 	object        *game.Object
 	actionManager *action.Manager
+	actions       input.Set
 	config        config.Jump
 
 	checkGround     *CheckGround
+	fall            *Fall
 	animator        *Animator
 	soundController *SoundController
 
 	usedImpulse        float64 // Defines the previously used jump impulse.
 	accumulatedImpulse float64 // Defines the current accumulated jump impulse.
+	charge             float64 // Defines the current jump charge, in the range [0; 1], from no impulse to the maximum impulse.
 	canJump            bool    // Defines if the object is able to jump.
+	overcharged        bool    // Defines if the jump action must be released before charging again, after an automatic release or a reset.
+	jumpAction         bool    // Defines if the jump action was being performed in the previous physics update.
 
-	actionBufferBeforeJump []string // Defines the action buffer, in frames, to be considered before the jump action is performed.
-	actionBufferAfterJump  []string // Defines the action buffer, in frames, to be considered after the jump action is performed.
+	bufferLength           int      // Defines the length of the action buffers in physics updates.
+	actionBufferBeforeJump []string // Defines the action buffer, in physics updates, to be considered before the jump action is performed.
+	actionBufferAfterJump  []string // Defines the action buffer, in physics updates, to be considered after the jump action is performed.
 }
 
 // NewJump returns a new jump behaviour with the given configuration.
 func NewJump(
 	object *game.Object,
 	actionManager *action.Manager,
+	actions input.Set,
 	config config.Jump,
 	checkGround *CheckGround,
+	fall *Fall,
 	animator *Animator,
 	soundController *SoundController,
 ) Jump {
 	return Jump{
 		object:          object,
 		actionManager:   actionManager,
+		actions:         actions,
 		config:          config,
 		checkGround:     checkGround,
+		fall:            fall,
 		animator:        animator,
 		soundController: soundController,
 
 		usedImpulse:        0,
 		accumulatedImpulse: 0,
 		canJump:            false,
-
-		actionBufferBeforeJump: make([]string, config.DirectionBuffer),
 	}
 }
 
@@ -63,7 +71,23 @@ func (b Jump) Enabled() bool {
 	return true
 }
 
-func (b *Jump) FixedUpdate(_ *engine.Engine) error {
+func (b *Jump) Start(e *engine.Engine) error {
+	time := e.Time()
+
+	// Compute the length of the action buffers from the configured duration, so that the buffer window is the same
+	// regardless of the rendering frame rate.
+	if time.FixedDeltaTime > 0 {
+		b.bufferLength = int(math.Ceil(b.config.DirectionBuffer / time.FixedDeltaTime))
+	}
+
+	b.actionBufferBeforeJump = make([]string, b.bufferLength)
+
+	return nil
+}
+
+func (b *Jump) FixedUpdate(e *engine.Engine) error {
+	time := e.Time()
+
 	// Check if the rigid body is accessible.
 	if b.object == nil {
 		return nil
@@ -72,11 +96,18 @@ func (b *Jump) FixedUpdate(_ *engine.Engine) error {
 		return nil
 	}
 
-	// Check if the object is falling.
-	if b.object.RigidBody.Velocity.Y < -Epsilon && !b.checkGround.TouchingGround() {
+	// Check if the object is falling. The ceiling hit animation is kept until the object lands.
+	if b.object.RigidBody.Velocity.Y < -Epsilon && !b.checkGround.TouchingGround() &&
+		b.animator.Animation() != animation.Bonk {
 		b.animator.SetAnimation(animation.JumpFall)
 	}
 
+	// Save actions in the buffer.
+	b.bufferAction()
+
+	// Charge the jump and check if it was released.
+	b.updateCharge(time.FixedDeltaTime)
+
 	// Check if the object can jump.
 	if !b.canJump {
 		return nil
@@ -103,7 +134,7 @@ func (b *Jump) FixedUpdate(_ *engine.Engine) error {
 	}
 
 	// Jump only if an action is taken within the expected buffer.
-	if len(action) == 0 && len(b.actionBufferAfterJump) <= b.config.DirectionBuffer {
+	if len(action) == 0 && len(b.actionBufferAfterJump) <= b.bufferLength {
 		return nil
 	}
 
@@ -115,12 +146,8 @@ func (b *Jump) FixedUpdate(_ *engine.Engine) error {
 
 	// Check that the left or right action is being performed.
 	if len(action) != 0 {
-		// Compute the diagonal angle based on the fraction of accumulated impulse.
-		diagonalAngle := b.config.DiagonalAngle
-		diagonalAngle *= b.accumulatedImpulse / b.config.MaxImpulse
-
 		// Compute the rotated direction from the right side.
-		rotation := matrix.FromEuler(diagonalAngle)
+		rotation := matrix.FromEuler(b.diagonalAngle())
 		direction = rotation.RotateVector(vector2.Right())
 
 		// If the object is performing a left action, invert the direction vector.
@@ -139,27 +166,41 @@ func (b *Jump) FixedUpdate(_ *engine.Engine) error {
 
 	// Reset the accumulated impulse and jump flag.
 	b.accumulatedImpulse = 0
+	b.charge = 0
 	b.canJump = false
 
 	return nil
 }
 
-func (b *Jump) Update(e *engine.Engine) error {
-	time := e.Time()
+// UsedImpulse returns the previously used jump impulse.
+func (b Jump) UsedImpulse() float64 {
+	return b.usedImpulse
+}
 
-	// Check if the rigid body is accessible.
-	if b.object == nil {
-		return nil
-	}
-	if b.object.RigidBody == nil {
-		return nil
-	}
+// MaxImpulse returns the maximum jump impulse.
+func (b Jump) MaxImpulse() float64 {
+	return b.config.MaxImpulse
+}
 
-	// Save actions in the buffer.
+// reset discards the jump charge and the buffered actions, so that a jump is not carried over after the object is
+// moved (e.g. teleported). A jump action held during the reset must be released before charging again, so that
+// releasing it does not jump with the minimum impulse.
+func (b *Jump) reset() {
+	b.accumulatedImpulse = 0
+	b.charge = 0
+	b.canJump = false
+	b.jumpAction = b.actionManager.Action(b.actions.Jump)
+	b.overcharged = b.jumpAction
+	b.actionBufferBeforeJump = make([]string, b.bufferLength)
+	b.actionBufferAfterJump = nil
+}
+
+// bufferAction saves the current left or right action in the action buffers.
+func (b *Jump) bufferAction() {
 	var action string
 
-	leftAction := b.actionManager.Action(input.Left)
-	rightAction := b.actionManager.Action(input.Right)
+	leftAction := b.actionManager.Action(b.actions.Left)
+	rightAction := b.actionManager.Action(b.actions.Right)
 	if leftAction && !rightAction {
 		action = input.Left
 	} else if !leftAction && rightAction {
@@ -169,46 +210,125 @@ func (b *Jump) Update(e *engine.Engine) error {
 	for i := len(b.actionBufferBeforeJump) - 1; i > 0; i-- {
 		b.actionBufferBeforeJump[i] = b.actionBufferBeforeJump[i-1]
 	}
-	b.actionBufferBeforeJump[0] = action
+	if len(b.actionBufferBeforeJump) != 0 {
+		b.actionBufferBeforeJump[0] = action
+	}
 
-	if len(b.actionBufferAfterJump) <= b.config.DirectionBuffer {
+	if len(b.actionBufferAfterJump) <= b.bufferLength {
 		b.actionBufferAfterJump = append(b.actionBufferAfterJump, action)
 	}
+}
 
-	// Check if the object is in contact with the ground and if the fall animation has already ended.
-	if !b.checkGround.TouchingGround() ||
-		(b.animator.Animation() == animation.Fall && !b.animator.AnimationEnded()) {
+// updateCharge accumulates the jump impulse while the jump action is being performed, and enables the jump when the
+// action is released.
+func (b *Jump) updateCharge(deltaTime float64) {
+	// Check if the jump action was released since the previous physics update.
+	jumpAction := b.actionManager.Action(b.actions.Jump)
+	jumpReleased := b.jumpAction && !jumpAction
+	b.jumpAction = jumpAction
+
+	// Check if the object is in contact with the ground and if it has already recovered from a fall.
+	if !b.checkGround.TouchingGround() || b.fall.Stunned() {
 		b.accumulatedImpulse = 0
-		return nil
+		b.charge = 0
+		if jumpReleased {
+			b.overcharged = false
+		}
+		return
 	}
 
-	// Check if the jump action is being performed.
-	if b.actionManager.Action(input.Jump) {
-		// Apply the impulse multiplier and ensure that the accumulated impulse is not greater than the maximum defined.
-		b.accumulatedImpulse += b.config.Impulse * time.DeltaTime
-		b.accumulatedImpulse = mathf.Min(b.accumulatedImpulse, b.config.MaxImpulse)
+	// Check if the jump action is being performed. After an automatic release, the jump action must be released
+	// before charging again.
+	if jumpAction && !b.overcharged {
+		// Apply the impulse multiplier, relative to the maximum impulse, and ensure that the charge is not greater
+		// than the maximum.
+		if b.config.MaxImpulse > 0 {
+			b.charge += b.config.Impulse * deltaTime / b.config.MaxImpulse
+		}
+		b.charge = mathf.Clamp(b.charge, 0, 1)
+		b.accumulatedImpulse = b.config.MaxImpulse * b.chargeCurve()
 
 		// Reset the horizontal velocity of the object when the jump action is being performed.
 		b.object.RigidBody.Velocity.X = 0
 		b.animator.SetAnimation(animation.JumpHold)
+
+		// Automatically release the jump when the maximum charge is reached.
+		if b.config.Overcharge == config.OverchargeRelease && b.charge >= 1 {
+			b.overcharged = true
+			b.release()
+		}
 	}
 
 	// Check if the jump action was released.
-	if b.actionManager.ActionEnded(input.Jump) {
-		// The object is able to jump
-		b.canJump = true
-		b.actionBufferAfterJump = make([]string, 0, b.config.DirectionBuffer)
+	if jumpReleased {
+		if b.overcharged {
+			// The jump was already released automatically.
+			b.overcharged = false
+			return
+		}
+
+		b.release()
 	}
+}
 
-	return nil
+// release enables the object to jump with the accumulated impulse.
+func (b *Jump) release() {
+	b.canJump = true
+	b.actionBufferAfterJump = make([]string, 0, b.bufferLength)
 }
 
-// UsedImpulse returns the previously used jump impulse.
-func (b Jump) UsedImpulse() float64 {
-	return b.usedImpulse
+// chargeCurve returns the fraction of the maximum impulse for the current charge, based on the configured curve.
+func (b Jump) chargeCurve() float64 {
+	switch b.config.ChargeCurve {
+	case config.ChargeCurveEaseIn:
+		return easeInQuad(b.charge)
+
+	case config.ChargeCurveEaseOut:
+		return easeOutQuad(b.charge)
+
+	case config.ChargeCurveSteps:
+		if b.config.ChargeLevels <= 0 {
+			return b.charge
+		}
+
+		// Round the charge up to the current charge level, so that there are exactly N levels from 1/N to the full
+		// impulse. Any charge reaches the first level, and only the full charge reaches the last one.
+		levels := float64(b.config.ChargeLevels)
+		return math.Ceil(b.charge*levels) / levels
+
+	default:
+		return b.charge
+	}
 }
 
-// MaxImpulse returns the maximum jump impulse.
-func (b Jump) MaxImpulse() float64 {
-	return b.config.MaxImpulse
+// diagonalAngle returns the diagonal angle in degrees for the accumulated impulse, based on the configured angle model.
+func (b Jump) diagonalAngle() float64 {
+	// Compute the fraction of accumulated impulse.
+	var fraction float64
+	if b.config.MaxImpulse > 0 {
+		fraction = mathf.Clamp(b.accumulatedImpulse/b.config.MaxImpulse, 0, 1)
+	}
+
+	switch b.config.AngleModel {
+	case config.AngleModelFixed:
+		return b.config.DiagonalAngle
+
+	case config.AngleModelTable:
+		table := b.config.AngleTable
+		if len(table) == 0 {
+			return b.config.DiagonalAngle
+		}
+		if len(table) == 1 {
+			return table[0]
+		}
+
+		// Interpolate between the two closest angles of the table.
+		position := fraction * float64(len(table)-1)
+		index := int(math.Min(math.Floor(position), float64(len(table)-2)))
+
+		return table[index] + (table[index+1]-table[index])*(position-float64(index))
+
+	default:
+		return b.config.DiagonalAngle * fraction
+	}
 }
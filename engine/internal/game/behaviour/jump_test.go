@@ -0,0 +1,101 @@
+package behaviour_test
+
+import (
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+)
+
+// floor defines the bottom row of a wide map, short enough for the player to land on it without being stunned.
+const floor = "###############"
+
+func TestJumpCharge(t *testing.T) {
+	m := tallMap(8, floor)
+
+	var previous float64
+	for _, seconds := range []float64{0, 0.1, 0.2, 0.3} {
+		w := landedWorld(t, m, 7)
+		start := w.Player().Position.Y
+
+		// Charge the jump, or tap it for the minimum impulse.
+		w.Hold(max(w.Seconds(seconds), 1), action.Jump)
+		w.AssertGrounded()
+		w.AssertAnimation(animation.JumpHold)
+
+		if player, _ := w.Debug(); player.Jump.Charge <= 0 {
+			t.Errorf("%vs: expected the jump to be charged", seconds)
+		}
+
+		// A longer charge jumps higher.
+		height := peak(w, w.Seconds(3)) - start
+		if height <= previous {
+			t.Errorf("%vs: expected the jump to be higher than %v, got %v", seconds, previous, height)
+		}
+		previous = height
+
+		w.AssertGrounded()
+	}
+}
+
+func TestJumpFall(t *testing.T) {
+	w := landedWorld(t, tallMap(8, floor), 7)
+
+	w.Hold(w.Seconds(0.2), action.Jump)
+	w.Wait(w.Seconds(0.2))
+	w.AssertAirborne()
+
+	// Wait for the player to start falling, then for the falling animation to be set in the next physics update.
+	for i := 0; i < w.Seconds(2) && w.Player().Velocity.Y >= 0; i++ {
+		w.Wait(1)
+	}
+	w.Wait(1)
+	w.AssertAirborne()
+	w.AssertAnimation(animation.JumpFall)
+
+	// The jump cannot be charged in the air.
+	w.Hold(w.Seconds(0.05), action.Jump)
+	if player, _ := w.Debug(); player.Jump.Charge != 0 {
+		t.Errorf("expected no jump charge in the air, got %v", player.Jump.Charge)
+	}
+
+	w.Wait(w.Seconds(2))
+	w.AssertGrounded()
+}
+
+func TestJumpDirection(t *testing.T) {
+	m := tallMap(8, floor)
+
+	tests := []struct {
+		name      string
+		direction []string // Defines the direction held right after the jump is released.
+		expected  float64  // Defines the expected sign of the horizontal displacement.
+	}{
+		{name: "up", expected: 0},
+		{name: "right", direction: []string{action.Right}, expected: 1},
+		{name: "left", direction: []string{action.Left}, expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := landedWorld(t, m, 7)
+			start := w.Player().Position
+
+			// Release the jump while holding the direction, within the direction buffer.
+			w.Hold(w.Seconds(0.2), action.Jump)
+			w.Hold(1, tt.direction...)
+			w.Wait(w.Seconds(3))
+			w.AssertGrounded()
+
+			displacement := w.Player().Position.X - start.X
+			switch {
+			case tt.expected == 0 && (displacement < -1 || displacement > 1):
+				t.Errorf("expected a vertical jump, moved %v", displacement)
+			case tt.expected > 0 && displacement <= float64(m.TileSize):
+				t.Errorf("expected a jump to the right, moved %v", displacement)
+			case tt.expected < 0 && displacement >= -float64(m.TileSize):
+				t.Errorf("expected a jump to the left, moved %v", displacement)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/goofr-group/jump-master/engine/internal/config"
 	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
 	"github.com/goofr-group/jump-master/engine/internal/game/sound"
 	"github.com/goofr-group/jump-master/engine/internal/game/tag"
 )
@@ -26,6 +27,7 @@ type KnockBack struct {
 	jump            *Jump
 	animator        *Animator
 	soundController *SoundController
+	eventController *EventController
 
 	// platforms defines the map of platform objects that the current object is in contact with. The map represents the
 	// state of the contact by the platform object id.
@@ -44,6 +46,7 @@ func NewKnockBack(
 	jump *Jump,
 	animator *Animator,
 	soundController *SoundController,
+	eventController *EventController,
 ) KnockBack {
 	return KnockBack{
 		object:          object,
@@ -53,6 +56,7 @@ func NewKnockBack(
 		jump:            jump,
 		animator:        animator,
 		soundController: soundController,
+		eventController: eventController,
 
 		platforms: make(map[int64]bool),
 	}
@@ -135,19 +139,15 @@ func (b *KnockBack) OnCollisionEnter(e *engine.Engine, otherID int64, manifold c
 	b.object.RigidBody.AddAcceleration(velocity)
 	b.animator.SetAnimation(animation.KnockBack)
 	b.soundController.AddPlayerSound(sound.KnockBack)
+	b.eventController.AddPlayerEvent(event.KnockBack)
 
 	return nil
 }
 
-func (b *KnockBack) OnCollisionExit(e *engine.Engine, otherID int64, _ collision.Manifold) error {
-	// Get the colliding object.
-	otherObject := e.World().GetGameObjectByID(otherID)
-	if otherObject == nil {
-		return nil
-	}
-
-	// Check if the colliding object contains the platform tag.
-	if otherObject.Tag != tag.Platform {
+func (b *KnockBack) OnCollisionExit(_ *engine.Engine, otherID int64, _ collision.Manifold) error {
+	// Check if the colliding object is a platform in contact with the object. The colliding object is not looked up,
+	// so that the contact is cleared even if it was removed from the game world.
+	if _, ok := b.platforms[otherID]; !ok {
 		return nil
 	}
 
@@ -157,6 +157,12 @@ func (b *KnockBack) OnCollisionExit(e *engine.Engine, otherID int64, _ collision
 	return nil
 }
 
+// reset discards the velocity of the previous physics update, so that a knock-back is not computed from the movement
+// before the object is moved (e.g. teleported).
+func (b *KnockBack) reset() {
+	b.previousVelocity = vector2.Vector2{}
+}
+
 // PlatformContact returns true if the current object is touching any platform. The platform is represented by any
 // object with the Platform tag.
 func (b KnockBack) PlatformContact() bool {
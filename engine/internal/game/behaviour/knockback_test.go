@@ -0,0 +1,93 @@
+package behaviour_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+	"github.com/goofr-group/jump-master/engine/internal/game/event"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+// wellMap returns a tall map with walls on both sides of an empty column of tiles of the given width.
+func wellMap(width int) config.Map {
+	rows := make([]string, 30)
+	for i := range rows {
+		rows[i] = "#" + strings.Repeat(" ", width) + "#"
+	}
+	rows[len(rows)-1] = strings.Repeat("#", width+2)
+
+	return gametest.Map(48, rows...)
+}
+
+func TestKnockBack(t *testing.T) {
+	m := wellMap(7)
+	w := landedWorld(t, m, 5)
+
+	// Jump diagonally into the wall on the right.
+	start := w.Ticks()
+	w.Hold(w.Seconds(0.3), action.Jump)
+	w.Hold(1, action.Right)
+
+	var hit float64
+	for i := 0; i < w.Seconds(3); i++ {
+		w.Wait(1)
+
+		if slices.Contains(w.EventsSince(w.Ticks()-1), event.KnockBack) {
+			w.AssertAnimation(animation.KnockBack)
+			hit = w.Player().Position.X
+			break
+		}
+	}
+
+	w.ExpectEvent(event.KnockBack, start)
+
+	// The player bounces off the wall and lands to its left.
+	w.Wait(w.Seconds(3))
+	w.AssertGrounded()
+
+	if x := w.Player().Position.X; x >= hit {
+		t.Errorf("expected the player to bounce to the left of %v, got %v", hit, x)
+	}
+}
+
+func TestNoKnockBack(t *testing.T) {
+	m := wellMap(7)
+
+	tests := []struct {
+		name    string
+		column  int
+		actions func(w *gametest.World)
+	}{
+		{
+			name:   "walk into wall",
+			column: 5,
+			actions: func(w *gametest.World) {
+				w.Hold(w.Seconds(1), action.Right)
+			},
+		},
+		{
+			name:   "vertical jump along wall",
+			column: 7,
+			actions: func(w *gametest.World) {
+				w.Hold(w.Seconds(0.3), action.Jump)
+				w.Wait(w.Seconds(3))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := landedWorld(t, m, tt.column)
+
+			start := w.Ticks()
+			tt.actions(w)
+
+			w.AssertGrounded()
+			w.ExpectNoEvent(event.KnockBack, start)
+		})
+	}
+}
@@ -17,29 +17,31 @@ import (
 type Movement struct {
 	object        *game.Object
 	actionManager *action.Manager
+	actions       input.Set
 	config        config.Movement
 
 	checkGround *CheckGround
+	fall        *Fall
 	animator    *Animator
-
-	leftAction  bool
-	rightAction bool
-	jumpAction  bool
 }
 
 // NewMovement returns a new movement behaviour with the given configuration.
 func NewMovement(
 	object *game.Object,
 	actionManager *action.Manager,
+	actions input.Set,
 	config config.Movement,
 	checkGround *CheckGround,
+	fall *Fall,
 	animator *Animator,
 ) Movement {
 	return Movement{
 		object:        object,
 		actionManager: actionManager,
+		actions:       actions,
 		config:        config,
 		checkGround:   checkGround,
+		fall:          fall,
 		animator:      animator,
 	}
 }
@@ -64,17 +66,22 @@ func (b *Movement) FixedUpdate(e *engine.Engine) error {
 		return nil
 	}
 
-	// Check if the fall animation has already ended.
-	if b.animator.Animation() == animation.Fall && !b.animator.AnimationEnded() {
+	// Check if the object has already recovered from a fall.
+	if b.fall.Stunned() {
 		return nil
 	}
 
+	// Get the actions.
+	leftAction := b.actionManager.Action(b.actions.Left)
+	rightAction := b.actionManager.Action(b.actions.Right)
+	jumpAction := b.actionManager.Action(b.actions.Jump)
+
 	// Check if the jump action is being performed.
-	if b.jumpAction {
-		if b.leftAction {
+	if jumpAction {
+		if leftAction {
 			b.object.SetProperty(property.FlipHorizontally, true)
 		}
-		if b.rightAction {
+		if rightAction {
 			b.object.SetProperty(property.FlipHorizontally, false)
 		}
 		return nil
@@ -82,12 +89,12 @@ func (b *Movement) FixedUpdate(e *engine.Engine) error {
 
 	// Compute the direction to add to the object based on the left and right actions.
 	var direction float64
-	if b.leftAction {
+	if leftAction {
 		// Add the left direction.
 		direction -= 1
 		b.object.SetProperty(property.FlipHorizontally, true)
 	}
-	if b.rightAction {
+	if rightAction {
 		// Add the right direction.
 		direction += 1
 		b.object.SetProperty(property.FlipHorizontally, false)
@@ -115,10 +122,5 @@ func (b *Movement) Update(_ *engine.Engine) error {
 	// Avoid the object from rotating.
 	b.object.Transform.Rotation = matrix.Identity()
 
-	// Update the actions.
-	b.leftAction = b.actionManager.Action(input.Left)
-	b.rightAction = b.actionManager.Action(input.Right)
-	b.jumpAction = b.actionManager.Action(input.Jump)
-
 	return nil
 }
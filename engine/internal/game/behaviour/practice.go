@@ -0,0 +1,141 @@
+package behaviour
+
+import (
+	"slices"
+
+	"github.com/goofr-group/go-math/vector2"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/animation"
+)
+
+// Snapshot defines the state of a player saved in a practice bookmark.
+type Snapshot struct {
+	Position  vector2.Vector2 // Defines the position of the player.
+	Velocity  vector2.Vector2 // Defines the velocity of the player.
+	Animation string          // Defines the current animation of the player.
+
+	usedImpulse            float64
+	accumulatedImpulse     float64
+	charge                 float64
+	canJump                bool
+	overcharged            bool
+	jumpAction             bool
+	actionBufferBeforeJump []string
+	actionBufferAfterJump  []string
+	fallTimer              float64
+	stunTimer              float64
+	contacts               contacts
+}
+
+// PlayerPractice defines the structure used to move a player in practice mode, keeping the internal state of its
+// behaviours consistent.
+type PlayerPractice struct {
+	object *game.Object
+
+	jump         *Jump
+	fall         *Fall
+	knockBack    *KnockBack
+	bonk         *Bonk
+	animator     *Animator
+	interpolator *Interpolator
+	contacts     PlayerContacts
+}
+
+// NewPlayerPractice returns a new practice controller for the given player behaviours.
+func NewPlayerPractice(
+	object *game.Object,
+	jump *Jump,
+	fall *Fall,
+	knockBack *KnockBack,
+	bonk *Bonk,
+	animator *Animator,
+	interpolator *Interpolator,
+	contacts PlayerContacts,
+) PlayerPractice {
+	return PlayerPractice{
+		object:       object,
+		jump:         jump,
+		fall:         fall,
+		knockBack:    knockBack,
+		bonk:         bonk,
+		animator:     animator,
+		interpolator: interpolator,
+		contacts:     contacts,
+	}
+}
+
+// Teleport moves the player to the given position at rest. The internal state of the behaviours is reset, so that no
+// jump, fall or knock-back is carried over from before the teleport, and the contacts with the platforms are
+// discarded until they are detected in the new position.
+func (p PlayerPractice) Teleport(position vector2.Vector2) {
+	// Check if the rigid body is accessible.
+	if p.object == nil || p.object.RigidBody == nil {
+		return
+	}
+
+	p.object.Transform.Position = position
+	p.object.RigidBody.Velocity = vector2.Vector2{}
+
+	p.jump.reset()
+	p.fall.reset()
+	p.knockBack.reset()
+	p.bonk.reset()
+	p.contacts.reset()
+	p.animator.SetAnimation(animation.Idle)
+	p.interpolator.Reset()
+}
+
+// Snapshot returns the current state of the player.
+func (p PlayerPractice) Snapshot() Snapshot {
+	// Check if the rigid body is accessible.
+	if p.object == nil || p.object.RigidBody == nil {
+		return Snapshot{}
+	}
+
+	return Snapshot{
+		Position:  p.object.Transform.Position,
+		Velocity:  p.object.RigidBody.Velocity,
+		Animation: p.animator.Animation(),
+
+		usedImpulse:            p.jump.usedImpulse,
+		accumulatedImpulse:     p.jump.accumulatedImpulse,
+		charge:                 p.jump.charge,
+		canJump:                p.jump.canJump,
+		overcharged:            p.jump.overcharged,
+		jumpAction:             p.jump.jumpAction,
+		actionBufferBeforeJump: slices.Clone(p.jump.actionBufferBeforeJump),
+		actionBufferAfterJump:  slices.Clone(p.jump.actionBufferAfterJump),
+		fallTimer:              p.fall.timer,
+		stunTimer:              p.fall.stunTimer,
+		contacts:               p.contacts.snapshot(),
+	}
+}
+
+// Restore moves the player to the state of the given snapshot, including the jump charge, the buffered actions and the
+// contacts with the platforms. The restored jump charge keeps accumulating while the jump action is held, and is
+// released when it is not.
+func (p PlayerPractice) Restore(snapshot Snapshot) {
+	// Check if the rigid body is accessible.
+	if p.object == nil || p.object.RigidBody == nil {
+		return
+	}
+
+	p.Teleport(snapshot.Position)
+
+	p.object.RigidBody.Velocity = snapshot.Velocity
+	p.jump.usedImpulse = snapshot.usedImpulse
+	p.jump.accumulatedImpulse = snapshot.accumulatedImpulse
+	p.jump.charge = snapshot.charge
+	p.jump.canJump = snapshot.canJump
+	p.jump.overcharged = snapshot.overcharged
+	p.jump.jumpAction = snapshot.jumpAction
+	p.jump.actionBufferBeforeJump = slices.Clone(snapshot.actionBufferBeforeJump)
+	p.jump.actionBufferAfterJump = slices.Clone(snapshot.actionBufferAfterJump)
+	p.fall.timer = snapshot.fallTimer
+	p.fall.stunTimer = snapshot.stunTimer
+	p.contacts.restore(snapshot.contacts)
+	p.knockBack.previousVelocity = snapshot.Velocity
+	p.bonk.previousVelocity = snapshot.Velocity
+	p.animator.SetAnimation(snapshot.Animation)
+}
@@ -0,0 +1,31 @@
+package behaviour
+
+import (
+	"github.com/goofr-group/game-engine/pkg/engine"
+)
+
+// RunTimer defines the structure of the run timer behaviour.
+type RunTimer struct {
+	elapsed float64 // Defines the time elapsed since the start of the run, in physics time.
+}
+
+// NewRunTimer returns a new run timer behaviour.
+func NewRunTimer() RunTimer {
+	return RunTimer{}
+}
+
+func (b RunTimer) Enabled() bool {
+	return true
+}
+
+func (b *RunTimer) FixedUpdate(e *engine.Engine) error {
+	// Update the elapsed time with the physics time, so that it matches the recorded runs.
+	b.elapsed += e.Time().FixedDeltaTime
+
+	return nil
+}
+
+// Elapsed returns the time elapsed since the start of the run in seconds.
+func (b RunTimer) Elapsed() float64 {
+	return b.elapsed
+}
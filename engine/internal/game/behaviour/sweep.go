@@ -0,0 +1,121 @@
+package behaviour
+
+import (
+	"math"
+
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/go-math/vector2"
+	"github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+)
+
+// Sweep defines the structure of the behaviour to prevent the object from moving through static tiles when moving
+// fast. It sweeps the bounds of the object along its displacement in the next physics update and, if a tile would be
+// crossed, limits the velocity so that the object stops at the tile surface.
+type Sweep struct {
+	object  *game.Object
+	gravity vector2.Vector2 // Defines the gravity of the game world, added to the velocity in the next physics update.
+
+	tiles    grid.Index // Defines the index of the static tiles by position.
+	tileSize float64    // Defines the size of the smallest tile.
+}
+
+// NewSweep returns a new sweep behaviour for the given static tiles and gravity of the game world.
+func NewSweep(
+	object *game.Object,
+	tiles []grid.Rect,
+	gravity vector2.Vector2,
+) Sweep {
+	b := Sweep{
+		object:  object,
+		gravity: gravity,
+	}
+	b.SetTiles(tiles)
+
+	return b
+}
+
+func (b Sweep) Enabled() bool {
+	return true
+}
+
+// SetTiles replaces the static tiles swept against (e.g. after the map is edited).
+func (b *Sweep) SetTiles(tiles []grid.Rect) {
+	tileSize := math.Inf(1)
+	for _, tile := range tiles {
+		tileSize = math.Min(tileSize, math.Min(tile.Max.X-tile.Min.X, tile.Max.Y-tile.Min.Y))
+	}
+
+	b.tiles = grid.NewIndex(tiles, tileSize)
+	b.tileSize = tileSize
+}
+
+func (b *Sweep) FixedUpdate(e *engine.Engine) error {
+	deltaTime := e.Time().FixedDeltaTime
+
+	// Check if the rigid body and collider are accessible.
+	if b.object == nil {
+		return nil
+	}
+	if b.object.RigidBody == nil {
+		return nil
+	}
+	if b.object.Collider == nil {
+		return nil
+	}
+
+	// Get the object bounds.
+	bounds := b.object.Collider.Bounds()
+	rect := grid.Rect{Min: bounds.Min, Max: bounds.Max}
+
+	// Compute the displacement of the object in the next physics update, where the gravity is added to the velocity
+	// before the position is integrated.
+	velocity := b.object.RigidBody.Velocity
+	gravity := b.gravity.Mul(b.object.RigidBody.GravityScale * deltaTime)
+	nextVelocity := vector2.Vector2{X: velocity.X + gravity.X, Y: velocity.Y + gravity.Y}
+	displacement := nextVelocity.Mul(deltaTime)
+
+	// Only sweep when the object moves far enough to skip a surface in a single physics update. Slower movement is
+	// handled by the discrete collision detection.
+	threshold := 0.5 * math.Min(b.tileSize, math.Min(rect.Max.X-rect.Min.X, rect.Max.Y-rect.Min.Y))
+	if math.Abs(displacement.X) < threshold && math.Abs(displacement.Y) < threshold {
+		return nil
+	}
+
+	// Get the tiles inside the swept bounds.
+	swept := grid.Rect{
+		Min: vector2.Vector2{X: rect.Min.X + math.Min(displacement.X, 0), Y: rect.Min.Y + math.Min(displacement.Y, 0)},
+		Max: vector2.Vector2{X: rect.Max.X + math.Max(displacement.X, 0), Y: rect.Max.Y + math.Max(displacement.Y, 0)},
+	}
+
+	// Find the first tile hit by the object.
+	hit := false
+	firstTime := math.Inf(1)
+	var firstNormalX bool
+
+	for _, tile := range b.tiles.Query(swept) {
+		t, normal, ok := rect.Sweep(displacement, tile)
+		if !ok || t >= firstTime {
+			continue
+		}
+
+		hit = true
+		firstTime = t
+		firstNormalX = normal.X != 0
+	}
+
+	if !hit {
+		return nil
+	}
+
+	// Limit the velocity along the hit surface normal, so that once the gravity is added the object stops exactly at
+	// the surface of the tile and the discrete collision detection resolves the contact.
+	if firstNormalX {
+		b.object.RigidBody.Velocity.X = nextVelocity.X*firstTime - gravity.X
+	} else {
+		b.object.RigidBody.Velocity.Y = nextVelocity.Y*firstTime - gravity.Y
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package behaviour_test
+
+import (
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+)
+
+func TestSweepDropOntoSingleTile(t *testing.T) {
+	engineConfig, playerConfig := loadConfigs(t)
+	engineConfig.Physics.CollisionDetection = config.CollisionDetectionSwept
+
+	// Drop the player from the top of a tall empty map onto a single tile at the bottom.
+	m := tallMap(60, "  #  ")
+	tile := grid.TileRect(m, 2, m.Height-1)
+	playerConfig.Object.Position = dropPosition(m, 2)
+
+	w := gametest.New(t, gametest.WithEngine(engineConfig), gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+	w.Wait(w.Seconds(5))
+
+	w.AssertGrounded()
+	w.AssertPositionNear(vector2.Vector2{
+		X: playerConfig.Object.Position.X,
+		Y: tile.Max.Y - playerConfig.Object.ColliderOffset.Y,
+	}, 1)
+}
+
+func TestSweepFastFall(t *testing.T) {
+	engineConfig, playerConfig := loadConfigs(t)
+
+	// Use a strong gravity, so that the player reaches the tile moving many times its height in each physics update.
+	engineConfig.Physics.Gravity = vector2.Vector2{Y: -150000}
+	playerConfig.Fall.TerminalVelocity = 75000
+
+	m := tallMap(500, "  #  ")
+	tile := grid.TileRect(m, 2, m.Height-1)
+	start := dropPosition(m, 2)
+
+	// The discrete collisions only stop the player if it overlaps the tile at the end of a physics update, so the fall
+	// is repeated from several heights across the distance moved in a physics update.
+	step := playerConfig.Fall.TerminalVelocity * engineConfig.Physics.UpdateRate
+	offsets := []float64{0, step / 4, step / 2, 3 * step / 4}
+
+	fall := func(collisionDetection string, offset float64) vector2.Vector2 {
+		engineConfig.Physics.CollisionDetection = collisionDetection
+		playerConfig.Object.Position = vector2.Vector2{X: start.X, Y: start.Y - offset}
+
+		w := gametest.New(t, gametest.WithEngine(engineConfig), gametest.WithPlayer(playerConfig), gametest.WithMap(m))
+		w.Wait(w.Seconds(3))
+
+		return w.Player().Position
+	}
+
+	// The swept collisions land the player on the tile from every height.
+	landing := tile.Max.Y - playerConfig.Object.ColliderOffset.Y
+	for _, offset := range offsets {
+		if position := fall(config.CollisionDetectionSwept, offset); position.Y < landing-1 {
+			t.Errorf("swept, offset %v: expected the player to land on the tile at %v, got %v", offset, landing, position)
+		}
+	}
+
+	// The discrete collisions let the player tunnel through the tile.
+	var tunneled int
+	for _, offset := range offsets {
+		if position := fall(config.CollisionDetectionDiscrete, offset); position.Y < tile.Min.Y {
+			tunneled++
+		}
+	}
+	if tunneled == 0 {
+		t.Error("discrete: expected the player to tunnel through the tile")
+	}
+}
@@ -52,3 +52,14 @@ func (e *Engine) Camera() *rendering.Camera {
 func (e *Engine) ActionManager() *action.Manager {
 	return e.actionManager
 }
+
+// DestroyGameObject removes the game object with the given identifier and its behaviours from the game world.
+func (e *Engine) DestroyGameObject(id int64) error {
+	return e.gameEngine.DestroyGameObject(id)
+}
+
+// IgnoreLayerCollision sets whether the colliders in the given collision layers ignore the collisions with each other.
+// The colliders in different layers collide by default.
+func (e *Engine) IgnoreLayerCollision(layerA, layerB string, ignore bool) {
+	e.physicsEngine.IgnoreLayerCollision(layerA, layerB, ignore)
+}
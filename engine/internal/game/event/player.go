@@ -0,0 +1,7 @@
+package event
+
+const (
+	Bonk      = "bonk"      // Represents the event of the player hitting the ceiling.
+	Fall      = "fall"      // Represents the event of the player landing after falling for longer than allowed.
+	KnockBack = "knockBack" // Represents the event of the player bouncing off a wall.
+)
@@ -0,0 +1,174 @@
+package grid
+
+import (
+	"math"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+)
+
+// Rect defines an axis-aligned rectangle in the game world.
+type Rect struct {
+	Min vector2.Vector2 // Defines the bottom left corner of the rectangle.
+	Max vector2.Vector2 // Defines the top right corner of the rectangle.
+}
+
+// Contains returns true if the given point is inside the rectangle.
+func (r Rect) Contains(point vector2.Vector2) bool {
+	return point.X >= r.Min.X && point.X <= r.Max.X && point.Y >= r.Min.Y && point.Y <= r.Max.Y
+}
+
+// Overlaps returns true if the given rectangle overlaps this rectangle.
+func (r Rect) Overlaps(other Rect) bool {
+	return r.Min.X < other.Max.X && r.Max.X > other.Min.X && r.Min.Y < other.Max.Y && r.Max.Y > other.Min.Y
+}
+
+// TilePosition returns the position in the game world of the center of the tile in the given map coordinates. The map
+// is defined from left to right, top to bottom, while the game world y-axis points upwards.
+func TilePosition(m config.Map, x, y int) vector2.Vector2 {
+	tileSize := float64(m.TileSize)
+
+	return vector2.Vector2{
+		X: float64(x) * tileSize,
+		Y: float64(m.Height-1-y) * tileSize,
+	}
+}
+
+// TileRect returns the rectangle in the game world occupied by the tile in the given map coordinates.
+func TileRect(m config.Map, x, y int) Rect {
+	return AreaRect(m, x, y, 1, 1)
+}
+
+// AreaRect returns the rectangle in the game world occupied by the area of tiles with the given top left map
+// coordinates and size in tiles.
+func AreaRect(m config.Map, x, y, width, height int) Rect {
+	halfTileSize := float64(m.TileSize) / 2
+
+	topLeft := TilePosition(m, x, y)
+	bottomRight := TilePosition(m, x+width-1, y+height-1)
+
+	return Rect{
+		Min: vector2.Vector2{X: topLeft.X - halfTileSize, Y: bottomRight.Y - halfTileSize},
+		Max: vector2.Vector2{X: bottomRight.X + halfTileSize, Y: topLeft.Y + halfTileSize},
+	}
+}
+
+// MapRect returns the rectangle in the game world occupied by the whole map.
+func MapRect(m config.Map) Rect {
+	return AreaRect(m, 0, 0, m.Width, m.Height)
+}
+
+// Index defines a spatial index of rectangles in a uniform grid of cells, used to look up the rectangles near an area
+// without checking every rectangle.
+type Index struct {
+	cellSize float64
+	rects    []Rect
+	cells    map[[2]int][]int // Defines the indices of the rectangles that overlap each cell.
+}
+
+// NewIndex returns a new index of the given rectangles with the given cell size. A non-positive cell size indexes
+// every rectangle in a single cell.
+func NewIndex(rects []Rect, cellSize float64) Index {
+	index := Index{
+		cellSize: cellSize,
+		rects:    rects,
+		cells:    make(map[[2]int][]int),
+	}
+
+	for i, rect := range rects {
+		minX, minY, maxX, maxY := index.cellRange(rect)
+		for x := minX; x <= maxX; x++ {
+			for y := minY; y <= maxY; y++ {
+				cell := [2]int{x, y}
+				index.cells[cell] = append(index.cells[cell], i)
+			}
+		}
+	}
+
+	return index
+}
+
+// Query returns the rectangles in the cells overlapped by the given area, without duplicates. The returned rectangles
+// are candidates that may not overlap the area themselves.
+func (i Index) Query(area Rect) []Rect {
+	minX, minY, maxX, maxY := i.cellRange(area)
+
+	var rects []Rect
+	seen := make(map[int]bool)
+	for x := minX; x <= maxX; x++ {
+		for y := minY; y <= maxY; y++ {
+			for _, rect := range i.cells[[2]int{x, y}] {
+				if seen[rect] {
+					continue
+				}
+
+				seen[rect] = true
+				rects = append(rects, i.rects[rect])
+			}
+		}
+	}
+
+	return rects
+}
+
+// cellRange returns the range of cells overlapped by the given rectangle.
+func (i Index) cellRange(rect Rect) (int, int, int, int) {
+	if i.cellSize <= 0 || math.IsInf(i.cellSize, 0) {
+		return 0, 0, 0, 0
+	}
+
+	return int(math.Floor(rect.Min.X / i.cellSize)), int(math.Floor(rect.Min.Y / i.cellSize)),
+		int(math.Floor(rect.Max.X / i.cellSize)), int(math.Floor(rect.Max.Y / i.cellSize))
+}
+
+// Sweep moves the rectangle by the given displacement and checks if it hits the other rectangle. It returns the
+// fraction, in the range [0; 1], of the displacement at which the rectangles touch, the normal of the hit surface of
+// the other rectangle and true if they hit. Rectangles that already overlap are not considered a hit.
+func (r Rect) Sweep(displacement vector2.Vector2, other Rect) (float64, vector2.Vector2, bool) {
+	entryX, exitX, ok := sweepAxis(r.Min.X, r.Max.X, other.Min.X, other.Max.X, displacement.X)
+	if !ok {
+		return 0, vector2.Vector2{}, false
+	}
+
+	entryY, exitY, ok := sweepAxis(r.Min.Y, r.Max.Y, other.Min.Y, other.Max.Y, displacement.Y)
+	if !ok {
+		return 0, vector2.Vector2{}, false
+	}
+
+	// The rectangles touch when they overlap in both axes.
+	entry := math.Max(entryX, entryY)
+	exit := math.Min(exitX, exitY)
+	if entry > exit || entry < 0 || entry > 1 {
+		return 0, vector2.Vector2{}, false
+	}
+
+	// The hit surface is the one of the last axis to overlap.
+	var normal vector2.Vector2
+	if entryX > entryY {
+		normal.X = -math.Copysign(1, displacement.X)
+	} else {
+		normal.Y = -math.Copysign(1, displacement.Y)
+	}
+
+	return entry, normal, true
+}
+
+// sweepAxis returns the fractions of the displacement at which the moving interval starts and stops overlapping the
+// static interval. It returns false if the intervals never overlap.
+func sweepAxis(minA, maxA, minB, maxB, displacement float64) (float64, float64, bool) {
+	switch {
+	case displacement > 0:
+		return (minB - maxA) / displacement, (maxB - minA) / displacement, true
+
+	case displacement < 0:
+		return (maxB - minA) / displacement, (minB - maxA) / displacement, true
+
+	default:
+		if maxA <= minB || minA >= maxB {
+			return 0, 0, false
+		}
+
+		return math.Inf(-1), math.Inf(1), true
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"github.com/goofr-group/jump-master/engine/internal/config"
 	"github.com/goofr-group/jump-master/engine/internal/game"
 	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
 )
 
-// NewCameraController creates the camera controller object and its behaviour to control the camera position.
-func NewCameraController(e game.Engine, config config.Camera) error {
+// NewCameraController creates the camera controller object and its behaviour to control the camera position following
+// the given players inside the map, and returns the camera controller behaviour.
+func NewCameraController(e game.Engine, cameraConfig config.Camera, mapConfig config.Map, playerObjects []*core.Object) (*behaviour.CameraController, error) {
 	gameEngine := e.Engine()
 	camera := e.Camera()
 
@@ -22,13 +24,13 @@ func NewCameraController(e game.Engine, config config.Camera) error {
 	}
 
 	// Create the behaviour.
-	cameraControllerBehaviour := behaviour.NewCameraController(camera, config.TransitionSpeed)
+	cameraControllerBehaviour := behaviour.NewCameraController(camera, playerObjects, cameraConfig, grid.MapRect(mapConfig))
 
 	// Add the camera controller game object to the game engine.
 	err := gameEngine.CreateGameObject(&gameObject, []engine.Behaviour{&cameraControllerBehaviour})
 	if err != nil {
-		return fmt.Errorf("failed to create camera controller game object: %w", err)
+		return nil, fmt.Errorf("failed to create camera controller game object: %w", err)
 	}
 
-	return nil
+	return &cameraControllerBehaviour, nil
 }
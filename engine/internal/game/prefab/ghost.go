@@ -0,0 +1,109 @@
+package prefab
+
+import (
+	"fmt"
+
+	"github.com/goofr-group/game-engine/pkg/engine"
+	"github.com/goofr-group/game-engine/pkg/rendering"
+	"github.com/goofr-group/go-math/rotation/matrix"
+	"github.com/goofr-group/go-math/vector2"
+	core "github.com/goofr-group/physics-engine/pkg/game"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game"
+	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+	"github.com/goofr-group/jump-master/engine/internal/game/property"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// NewRunTimer creates the run timer object and its behaviour to measure the elapsed time of the run, and returns the
+// run timer behaviour.
+func NewRunTimer(e game.Engine) (*behaviour.RunTimer, error) {
+	gameEngine := e.Engine()
+
+	// Create the run timer game object.
+	gameObject := core.Object{
+		Active: true,
+	}
+
+	// Create the behaviour.
+	runTimerBehaviour := behaviour.NewRunTimer()
+
+	// Add the run timer game object to the game engine.
+	err := gameEngine.CreateGameObject(&gameObject, []engine.Behaviour{&runTimerBehaviour})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create run timer game object: %w", err)
+	}
+
+	return &runTimerBehaviour, nil
+}
+
+// Ghost defines the structure of the ghost prefab.
+type Ghost struct {
+	Object       *core.Object            // Defines the ghost object.
+	Interpolator *behaviour.Interpolator // Defines the interpolator of the ghost position between physics updates.
+}
+
+// NewGhost creates the ghost object and its behaviours to play the given trace, and returns the ghost prefab. Ghosts
+// do not have a collider or rigid body, so they do not interact with the world.
+func NewGhost(e game.Engine, trace replay.Trace, runTimer *behaviour.RunTimer, playerConfig config.Player, ghostConfig config.Ghost) (Ghost, error) {
+	gameEngine := e.Engine()
+
+	// Create the ghost game object.
+	gameObject := newGhostObject(playerConfig)
+
+	// Create the behaviours.
+	ghostBehaviour := behaviour.NewGhost(&gameObject, trace, runTimer, ghostConfig.Opacity)
+	interpolatorBehaviour := behaviour.NewInterpolator(&gameObject)
+
+	// Add the ghost game object to the game engine.
+	err := gameEngine.CreateGameObject(&gameObject, []engine.Behaviour{&interpolatorBehaviour, &ghostBehaviour})
+	if err != nil {
+		return Ghost{}, fmt.Errorf("failed to create ghost game object: %w", err)
+	}
+
+	return Ghost{
+		Object:       &gameObject,
+		Interpolator: &interpolatorBehaviour,
+	}, nil
+}
+
+// NewOpponent creates the object of an opponent of a race, rendered as a ghost, and returns it. The opponent does not
+// have behaviours, since its state is received from the race server.
+func NewOpponent(e game.Engine, playerConfig config.Player, ghostConfig config.Ghost) (*core.Object, error) {
+	gameEngine := e.Engine()
+
+	// Create the opponent game object.
+	gameObject := newGhostObject(playerConfig)
+	gameObject.SetProperty(property.Opacity, ghostConfig.Opacity)
+
+	// Add the opponent game object to the game engine.
+	err := gameEngine.CreateGameObject(&gameObject, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create opponent game object: %w", err)
+	}
+
+	return &gameObject, nil
+}
+
+// newGhostObject returns the object of a ghost, rendered with the size of the player in its spawn position.
+func newGhostObject(playerConfig config.Player) core.Object {
+	rendererSize := playerConfig.Object.RendererSize
+
+	return core.Object{
+		Active: true,
+		Tag:    tag.Ghost,
+		Transform: core.Transform2D{
+			Position: playerConfig.Object.Position,
+			Rotation: matrix.Identity(),
+			Scale:    vector2.One(),
+		},
+		Renderer: &core.Renderer{
+			Width:  rendererSize.X,
+			Height: rendererSize.Y,
+			Offset: rendererSize.Div(-2),
+			Layer:  rendering.DefaultRenderLayer,
+		},
+	}
+}
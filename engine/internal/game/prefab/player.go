@@ -11,18 +11,42 @@ import (
 
 	"github.com/goofr-group/jump-master/engine/internal/config"
 	"github.com/goofr-group/jump-master/engine/internal/game"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
 	"github.com/goofr-group/jump-master/engine/internal/game/behaviour"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
 	"github.com/goofr-group/jump-master/engine/internal/game/tag"
 )
 
-// NewPlayer creates the player object and behaviours for the given configuration.
-func NewPlayer(e game.Engine, config config.Player) error {
+// collisionHistory defines the number of recent collisions of the player recorded for debugging.
+const collisionHistory = 16
+
+// Player defines the structure of the player prefab.
+type Player struct {
+	Object       *core.Object             // Defines the player object.
+	Debugger     behaviour.PlayerDebugger // Defines the debugger of the player behaviours.
+	Interpolator *behaviour.Interpolator  // Defines the interpolator of the player position between physics updates.
+	Practice     behaviour.PlayerPractice // Defines the controller used to move the player in practice mode.
+	Contacts     behaviour.PlayerContacts // Defines the controller of the player contacts with the platforms.
+	Sweep        *behaviour.Sweep         // Defines the sweep of the player against the static tiles, or nil if the collisions are discrete.
+}
+
+// NewPlayer creates the player object and behaviours for the given configuration and player slot, and returns the
+// player prefab. The engine configuration is used to define how the player collides with the world, and the map
+// configuration to define the regions and static tiles of the map that affect the player.
+func NewPlayer(e game.Engine, playerConfig config.Player, slot config.PlayerSlot, engineConfig config.Engine, mapConfig config.Map) (Player, error) {
 	gameEngine := e.Engine()
 	actionManager := e.ActionManager()
+	actions := action.NewSet(slot.ActionPrefix)
 
-	colliderSize := config.Object.ColliderSize
-	colliderOffset := config.Object.ColliderOffset
-	rendererSize := config.Object.RendererSize
+	// Use the animations of the player skin, if defined.
+	animations := playerConfig.Animations
+	if slot.Animations != nil {
+		animations = slot.Animations
+	}
+
+	colliderSize := playerConfig.Object.ColliderSize
+	colliderOffset := playerConfig.Object.ColliderOffset
+	rendererSize := playerConfig.Object.RendererSize
 
 	// Create the game object to check if the player is in contact with the ground.
 	colliderCheckGround := core.NewBoxCollider(
@@ -66,11 +90,16 @@ func NewPlayer(e game.Engine, config config.Player) error {
 
 	// Create the player game object.
 	colliderPlayer := core.NewBoxCollider(colliderSize, colliderOffset)
+	if !engineConfig.PlayerCollision && len(engineConfig.Players) > 1 {
+		// Place each player in its own collision layer, so that the collisions between the player layers can be
+		// ignored.
+		colliderPlayer.Layer = PlayerLayer(slot)
+	}
 	gameObjectPlayer := core.Object{
 		Active: true,
 		Tag:    tag.Player,
 		Transform: core.Transform2D{
-			Position: config.Object.Position,
+			Position: slot.Position,
 			Rotation: matrix.Identity(),
 			Scale:    vector2.One(),
 		},
@@ -78,9 +107,9 @@ func NewPlayer(e game.Engine, config config.Player) error {
 			BodyType:           core.BodyDynamic,
 			CollisionDetection: core.DiscreteDetection,
 			Interpolation:      core.Interpolate,
-			Mass:               config.Object.Mass,
+			Mass:               playerConfig.Object.Mass,
 			GravityScale:       1,
-			Drag:               config.Object.Drag,
+			Drag:               playerConfig.Object.Drag,
 		},
 		Collider: &colliderPlayer,
 		Renderer: &core.Renderer{
@@ -94,30 +123,103 @@ func NewPlayer(e game.Engine, config config.Player) error {
 	// Create the behaviours.
 	checkGroundBehaviour := behaviour.NewCheckGround(&gameObjectCheckGround)
 	checkCeilingBehaviour := behaviour.NewCheckCeiling(&gameObjectCheckCeiling)
-	animatorBehaviour := behaviour.NewAnimator(&gameObjectPlayer, config.Animations)
+	animatorBehaviour := behaviour.NewAnimator(&gameObjectPlayer, animations)
 	soundControllerBehaviour := behaviour.NewSoundController(&gameObjectPlayer)
-	movementBehaviour := behaviour.NewMovement(&gameObjectPlayer, actionManager, config.Movement, &checkGroundBehaviour, &animatorBehaviour)
-	jumpBehaviour := behaviour.NewJump(&gameObjectPlayer, actionManager, config.Jump, &checkGroundBehaviour, &animatorBehaviour, &soundControllerBehaviour)
-	fallBehaviour := behaviour.NewFall(&gameObjectPlayer, config.Fall, &checkGroundBehaviour, &animatorBehaviour, &soundControllerBehaviour)
-	knockBackBehaviour := behaviour.NewKnockBack(&gameObjectPlayer, config.KnockBack, &checkGroundBehaviour, &checkCeilingBehaviour, &jumpBehaviour, &animatorBehaviour, &soundControllerBehaviour)
+	eventControllerBehaviour := behaviour.NewEventController(&gameObjectPlayer)
+	fallBehaviour := behaviour.NewFall(&gameObjectPlayer, playerConfig.Fall, engineConfig.Physics.Gravity, &checkGroundBehaviour, &animatorBehaviour, &soundControllerBehaviour, &eventControllerBehaviour)
+	movementBehaviour := behaviour.NewMovement(&gameObjectPlayer, actionManager, actions, playerConfig.Movement, &checkGroundBehaviour, &fallBehaviour, &animatorBehaviour)
+	jumpBehaviour := behaviour.NewJump(&gameObjectPlayer, actionManager, actions, playerConfig.Jump, &checkGroundBehaviour, &fallBehaviour, &animatorBehaviour, &soundControllerBehaviour)
+	knockBackBehaviour := behaviour.NewKnockBack(&gameObjectPlayer, playerConfig.KnockBack, &checkGroundBehaviour, &checkCeilingBehaviour, &jumpBehaviour, &animatorBehaviour, &soundControllerBehaviour, &eventControllerBehaviour)
+	gravityBehaviour := behaviour.NewGravity(&gameObjectPlayer, gravityRegions(mapConfig))
+	bonkBehaviour := behaviour.NewBonk(&gameObjectPlayer, playerConfig.Ceiling, &checkCeilingBehaviour, &animatorBehaviour, &soundControllerBehaviour, &eventControllerBehaviour)
+	collisionRecorderBehaviour := behaviour.NewCollisionRecorder(&gameObjectPlayer, collisionHistory)
+	interpolatorBehaviour := behaviour.NewInterpolator(&gameObjectPlayer)
+
+	contacts := behaviour.NewPlayerContacts(&checkGroundBehaviour, &checkCeilingBehaviour, &knockBackBehaviour)
+
+	// The interpolator is the first behaviour, so that it records the position before any other behaviour changes it.
+	// The gravity is updated before the fall, which limits the falling speed with the gravity of the next physics update.
+	behaviours := []engine.Behaviour{&interpolatorBehaviour, &gravityBehaviour, &movementBehaviour, &jumpBehaviour, &fallBehaviour, &knockBackBehaviour, &bonkBehaviour, &animatorBehaviour, &soundControllerBehaviour, &eventControllerBehaviour, &collisionRecorderBehaviour}
+
+	// Sweep the player against the static tiles after every other behaviour has updated its velocity.
+	var sweepBehaviour *behaviour.Sweep
+	if engineConfig.Physics.CollisionDetection == config.CollisionDetectionSwept {
+		sweep := behaviour.NewSweep(&gameObjectPlayer, StaticTiles(mapConfig), engineConfig.Physics.Gravity)
+		sweepBehaviour = &sweep
+		behaviours = append(behaviours, sweepBehaviour)
+	}
 
 	// Add the player game object to the game engine.
-	err := gameEngine.CreateGameObject(&gameObjectPlayer, []engine.Behaviour{&movementBehaviour, &jumpBehaviour, &fallBehaviour, &knockBackBehaviour, &animatorBehaviour, &soundControllerBehaviour})
+	err := gameEngine.CreateGameObject(&gameObjectPlayer, behaviours)
 	if err != nil {
-		return fmt.Errorf("failed to create player game object: %w", err)
+		return Player{}, fmt.Errorf("failed to create player game object: %w", err)
 	}
 
 	// Add the check ground game object to the game engine.
 	err = gameEngine.CreateGameObjectWithParent(&gameObjectCheckGround, &gameObjectPlayer.Transform, []engine.Behaviour{&checkGroundBehaviour})
 	if err != nil {
-		return fmt.Errorf("failed to create check ground game object: %w", err)
+		return Player{}, fmt.Errorf("failed to create check ground game object: %w", err)
 	}
 
 	// Add the check ceiling game object to the game engine.
 	err = gameEngine.CreateGameObjectWithParent(&gameObjectCheckCeiling, &gameObjectPlayer.Transform, []engine.Behaviour{&checkCeilingBehaviour})
 	if err != nil {
-		return fmt.Errorf("failed to create check ceiling game object: %w", err)
+		return Player{}, fmt.Errorf("failed to create check ceiling game object: %w", err)
+	}
+
+	return Player{
+		Object:       &gameObjectPlayer,
+		Debugger:     behaviour.NewPlayerDebugger(&gameObjectPlayer, &checkGroundBehaviour, &checkCeilingBehaviour, &jumpBehaviour, &fallBehaviour, &knockBackBehaviour, &animatorBehaviour, &collisionRecorderBehaviour),
+		Interpolator: &interpolatorBehaviour,
+		Practice:     behaviour.NewPlayerPractice(&gameObjectPlayer, &jumpBehaviour, &fallBehaviour, &knockBackBehaviour, &bonkBehaviour, &animatorBehaviour, &interpolatorBehaviour, contacts),
+		Contacts:     contacts,
+		Sweep:        sweepBehaviour,
+	}, nil
+}
+
+// PlayerLayer returns the collision layer of the player in the given slot, used when the players do not collide with
+// each other.
+func PlayerLayer(slot config.PlayerSlot) string {
+	return tag.Player + slot.ActionPrefix
+}
+
+// IgnorePlayerCollisions configures the collision layers of the players in the given slots to ignore the collisions
+// with each other. The players still collide with the rest of the world.
+func IgnorePlayerCollisions(e game.Engine, slots []config.PlayerSlot) {
+	for i := range slots {
+		for j := i + 1; j < len(slots); j++ {
+			e.IgnoreLayerCollision(PlayerLayer(slots[i]), PlayerLayer(slots[j]), true)
+		}
+	}
+}
+
+// gravityRegions returns the gravity regions of the given map configuration in the game world.
+func gravityRegions(mapConfig config.Map) []behaviour.GravityRegion {
+	regions := make([]behaviour.GravityRegion, len(mapConfig.GravityRegions))
+	for i, region := range mapConfig.GravityRegions {
+		regions[i] = behaviour.GravityRegion{
+			Name:         region.Name,
+			Bounds:       grid.AreaRect(mapConfig, region.X, region.Y, region.Width, region.Height),
+			GravityScale: region.GravityScale,
+		}
+	}
+
+	return regions
+}
+
+// StaticTiles returns the bounds in the game world of the tiles of the given map configuration that can collide with
+// the player.
+func StaticTiles(mapConfig config.Map) []grid.Rect {
+	var tiles []grid.Rect
+	for _, layer := range mapConfig.Layers {
+		if !layer.Collider {
+			continue
+		}
+
+		for _, tile := range layer.Tiles {
+			tiles = append(tiles, grid.TileRect(mapConfig, tile.X, tile.Y))
+		}
 	}
 
-	return nil
+	return tiles
 }
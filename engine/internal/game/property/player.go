@@ -4,4 +4,8 @@ const (
 	Image            = "Image"            // Represents the player image property.
 	FlipHorizontally = "FlipHorizontally" // Represents the property that tells whether the player should be flipped horizontally or not.
 	Sounds           = "Sounds"           // Represents the player sounds property.
+	Events           = "Events"           // Represents the player events property.
+	GravityRegion    = "GravityRegion"    // Represents the property that tells the name of the gravity region the player is in.
+	TerminalVelocity = "TerminalVelocity" // Represents the property that tells whether the player is falling at terminal velocity or not.
+	Opacity          = "Opacity"          // Represents the property that tells the opacity used to render the object.
 )
@@ -5,4 +5,5 @@ const (
 	KnockBack = "knockBack" // Represents the player knock-back sound.
 	Landing   = "landing"   // Represents the player landing sound.
 	Fall      = "fall"      // Represents the player fall sound.
+	Bonk      = "bonk"      // Represents the player ceiling hit sound.
 )
@@ -3,4 +3,5 @@ package tag
 const (
 	Player   = "Player"   // Represents the player tag.
 	Platform = "Platform" // Represents the platform tag.
+	Ghost    = "Ghost"    // Represents the ghost run tag.
 )
@@ -0,0 +1,93 @@
+package gametest
+
+import (
+	"math"
+	"slices"
+
+	"github.com/goofr-group/go-math/vector2"
+)
+
+// AssertGrounded fails the test if the player is not touching the ground.
+func (w *World) AssertGrounded() {
+	w.tb.Helper()
+
+	if player, _ := w.Debug(); len(player.Grounds) == 0 {
+		w.tb.Errorf("tick %d: expected the player to be grounded, position %v", w.ticks, w.Player().Position)
+	}
+}
+
+// AssertAirborne fails the test if the player is touching the ground.
+func (w *World) AssertAirborne() {
+	w.tb.Helper()
+
+	if player, _ := w.Debug(); len(player.Grounds) > 0 {
+		w.tb.Errorf("tick %d: expected the player to be airborne, touching grounds %v", w.ticks, player.Grounds)
+	}
+}
+
+// AssertAnimation fails the test if the current animation of the player is not the given one.
+func (w *World) AssertAnimation(animation string) {
+	w.tb.Helper()
+
+	if player, _ := w.Debug(); player.Animation.Name != animation {
+		w.tb.Errorf("tick %d: expected animation %q, got %q", w.ticks, animation, player.Animation.Name)
+	}
+}
+
+// AssertPositionNear fails the test if the player position is farther than the given tolerance from the given
+// position on any axis.
+func (w *World) AssertPositionNear(position vector2.Vector2, tolerance float64) {
+	w.tb.Helper()
+
+	actual := w.Player().Position
+	if math.Abs(actual.X-position.X) > tolerance || math.Abs(actual.Y-position.Y) > tolerance {
+		w.tb.Errorf("tick %d: expected position %v within %v, got %v", w.ticks, position, tolerance, actual)
+	}
+}
+
+// AssertVelocityNear fails the test if the player velocity is farther than the given tolerance from the given
+// velocity on any axis.
+func (w *World) AssertVelocityNear(velocity vector2.Vector2, tolerance float64) {
+	w.tb.Helper()
+
+	actual := w.Player().Velocity
+	if math.Abs(actual.X-velocity.X) > tolerance || math.Abs(actual.Y-velocity.Y) > tolerance {
+		w.tb.Errorf("tick %d: expected velocity %v within %v, got %v", w.ticks, velocity, tolerance, actual)
+	}
+}
+
+// AssertStunned fails the test if the player is not stunned by a fall.
+func (w *World) AssertStunned() {
+	w.tb.Helper()
+
+	if player, _ := w.Debug(); player.Fall.StunTimer <= 0 {
+		w.tb.Errorf("tick %d: expected the player to be stunned", w.ticks)
+	}
+}
+
+// AssertCameraLevel fails the test if the screen level of the camera is not the given one.
+func (w *World) AssertCameraLevel(level int) {
+	w.tb.Helper()
+
+	if _, camera := w.Debug(); camera.Level != level {
+		w.tb.Errorf("tick %d: expected camera level %d, got %d", w.ticks, level, camera.Level)
+	}
+}
+
+// ExpectEvent fails the test if the given event of the player did not occur after the given physics step.
+func (w *World) ExpectEvent(event string, since int) {
+	w.tb.Helper()
+
+	if events := w.EventsSince(since); !slices.Contains(events, event) {
+		w.tb.Errorf("tick %d: expected event %q since tick %d, got %v", w.ticks, event, since, events)
+	}
+}
+
+// ExpectNoEvent fails the test if the given event of the player occurred after the given physics step.
+func (w *World) ExpectNoEvent(event string, since int) {
+	w.tb.Helper()
+
+	if events := w.EventsSince(since); slices.Contains(events, event) {
+		w.tb.Errorf("tick %d: unexpected event %q since tick %d", w.ticks, event, since)
+	}
+}
@@ -0,0 +1,134 @@
+package gametest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/goofr-group/go-math/vector2"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/grid"
+	"github.com/goofr-group/jump-master/engine/internal/game/tag"
+)
+
+// tileSize defines the tile size of the scenario maps.
+const tileSize = 48
+
+// Scenario defines the configurations of a scenario drawn in a map.
+type Scenario struct {
+	Engine config.Engine // Defines the engine configuration of the game.
+	Player config.Player // Defines the player configuration of the game, with the spawn position to be placed in the map.
+	Map    config.Map    // Defines the drawn map configuration.
+}
+
+// NewScenario returns the engine and player configurations of the game with the map drawn by the given rows, in the
+// same way as Map. Fails the test if the configurations cannot be loaded.
+func NewScenario(tb testing.TB, rows ...string) Scenario {
+	tb.Helper()
+
+	engineConfig, playerConfig, _ := Configs(tb)
+
+	return Scenario{
+		Engine: engineConfig,
+		Player: playerConfig,
+		Map:    Map(tileSize, rows...),
+	}
+}
+
+// Options returns the options to start a world with the configurations of the scenario.
+func (s Scenario) Options() []Option {
+	return []Option{WithEngine(s.Engine), WithPlayer(s.Player), WithMap(s.Map)}
+}
+
+// Floor returns the rows of a map with the given number of rows, empty except for the given floor in the bottom row.
+func Floor(rows int, floor string) []string {
+	drawn := make([]string, rows)
+	for i := range drawn {
+		drawn[i] = strings.Repeat(" ", len(floor))
+	}
+	drawn[rows-1] = floor
+
+	return drawn
+}
+
+// Standing returns the position of the player standing on the tile in the given map coordinates.
+func (s Scenario) Standing(x, y int) vector2.Vector2 {
+	tile := grid.TileRect(s.Map, x, y)
+
+	return vector2.Vector2{
+		X: (tile.Min.X + tile.Max.X) / 2,
+		Y: tile.Max.Y - s.Player.Object.ColliderOffset.Y,
+	}
+}
+
+// Drop returns the position of the player in the top row of the map, centered on the given column.
+func (s Scenario) Drop(x int) vector2.Vector2 {
+	tile := grid.TileRect(s.Map, x, 0)
+
+	return vector2.Vector2{
+		X: (tile.Min.X + tile.Max.X) / 2,
+		Y: grid.TilePosition(s.Map, x, 0).Y,
+	}
+}
+
+// Configs returns the engine, player and map configurations of the game. Fails the test if they cannot be loaded.
+func Configs(tb testing.TB) (config.Engine, config.Player, config.Map) {
+	tb.Helper()
+
+	engineConfig, err := config.LoadEngine()
+	if err != nil {
+		tb.Fatalf("failed to load engine configuration: %v", err)
+	}
+
+	playerConfig, err := config.LoadPlayer()
+	if err != nil {
+		tb.Fatalf("failed to load player configuration: %v", err)
+	}
+
+	mapConfig, err := config.LoadMap()
+	if err != nil {
+		tb.Fatalf("failed to load map configuration: %v", err)
+	}
+
+	return engineConfig, playerConfig, mapConfig
+}
+
+// Config returns the configuration defined inline in the given JSON. Fails the test if it cannot be unmarshalled.
+func Config[T any](tb testing.TB, data string) T {
+	tb.Helper()
+
+	var c T
+	if err := json.Unmarshal([]byte(data), &c); err != nil {
+		tb.Fatalf("failed to unmarshal configuration: %v", err)
+	}
+
+	return c
+}
+
+// Map returns the map configuration drawn by the given rows, from top to bottom, with the given tile size. Each '#'
+// is a platform tile that collides with the player, and any other character is empty.
+func Map(tileSize int, rows ...string) config.Map {
+	platforms := config.Layer{
+		Name:     tag.Platform,
+		Collider: true,
+	}
+
+	var width int
+	for y, row := range rows {
+		width = max(width, len(row))
+
+		for x, c := range row {
+			if c == '#' {
+				platforms.Tiles = append(platforms.Tiles, config.Tile{X: x, Y: y})
+			}
+		}
+	}
+
+	return config.Map{
+		TileSize: tileSize,
+		Width:    width,
+		Height:   len(rows),
+		Layers:   []config.Layer{platforms},
+	}
+}
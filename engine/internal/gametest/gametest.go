@@ -0,0 +1,206 @@
+// Package gametest provides a harness to run scripted scenarios in a headless game world with deterministic steps, so
+// that the behaviours can be tested with the same wiring as the game.
+//
+// A scenario builds a world, applies a timeline of actions and asserts the resulting state:
+//
+//	w := gametest.New(t, gametest.WithMap(gametest.Map(16,
+//		"#      #",
+//		"#      #",
+//		"########",
+//	)))
+//	w.Wait(w.Seconds(1))
+//	w.AssertGrounded()
+//
+//	start := w.Ticks()
+//	w.Hold(w.Seconds(0.5), action.Jump)
+//	w.Hold(w.Seconds(1), action.Right)
+//	w.ExpectEvent(event.KnockBack, start)
+package gametest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+// Event defines an event of the player that occurred in a physics step.
+type Event struct {
+	Tick  int    // Defines the physics step, since the start of the scenario, in which the event occurred.
+	Event string // Defines the name of the event.
+}
+
+// World defines the structure of a headless game world used in a scenario. The world has a single player, controlled
+// with the actions without a namespace, and is always stepped with the physics update rate.
+type World struct {
+	tb  testing.TB
+	app *app.App
+
+	engineConfig config.Engine
+	playerConfig config.Player
+	mapConfig    config.Map
+
+	ticks  int     // Defines the number of physics steps since the start of the scenario.
+	events []Event // Defines the events of the player since the start of the scenario.
+}
+
+// Option defines a function that changes the configurations of the world before it is started.
+type Option func(w *World)
+
+// WithEngine uses the given engine configuration instead of the game configuration.
+func WithEngine(engineConfig config.Engine) Option {
+	return func(w *World) {
+		w.engineConfig = engineConfig
+	}
+}
+
+// WithPlayer uses the given player configuration instead of the game configuration.
+func WithPlayer(playerConfig config.Player) Option {
+	return func(w *World) {
+		w.playerConfig = playerConfig
+	}
+}
+
+// WithMap uses the given map configuration instead of the game configuration.
+func WithMap(mapConfig config.Map) Option {
+	return func(w *World) {
+		w.mapConfig = mapConfig
+	}
+}
+
+// New starts a new world with the game configurations, changed by the given options. Fails the test if the world
+// cannot be started.
+func New(tb testing.TB, options ...Option) *World {
+	tb.Helper()
+
+	engineConfig, playerConfig, mapConfig := Configs(tb)
+
+	w := &World{
+		tb:           tb,
+		engineConfig: engineConfig,
+		playerConfig: playerConfig,
+		mapConfig:    mapConfig,
+	}
+
+	for _, option := range options {
+		option(w)
+	}
+
+	// Use a single player without an action namespace, in the same way as the replays.
+	w.engineConfig.Players = nil
+
+	w.app = app.New(w.engineConfig, w.playerConfig, w.mapConfig)
+	if err := w.app.StartGameWorld(); err != nil {
+		tb.Fatalf("failed to start game world: %v", err)
+	}
+
+	return w
+}
+
+// App returns the application of the world, for checks not covered by the assertions.
+func (w *World) App() *app.App {
+	return w.app
+}
+
+// Ticks returns the number of physics steps since the start of the scenario.
+func (w *World) Ticks() int {
+	return w.ticks
+}
+
+// Seconds returns the number of physics steps in the given duration in seconds, rounded up.
+func (w *World) Seconds(seconds float64) int {
+	updateRate := w.app.UpdateRate()
+	ticks := int(seconds / updateRate)
+	if float64(ticks)*updateRate < seconds {
+		ticks++
+	}
+
+	return ticks
+}
+
+// Run steps the world with the given timeline of inputs. Fails the test if a step fails.
+func (w *World) Run(inputs ...replay.Input) {
+	w.tb.Helper()
+
+	for _, input := range inputs {
+		w.Step(input.Ticks, input.Actions)
+	}
+}
+
+// Hold steps the world for the given number of physics steps while the given actions are held and the others are
+// released.
+func (w *World) Hold(ticks int, actions ...string) {
+	w.tb.Helper()
+
+	w.Step(ticks, action.States(actions...))
+}
+
+// Wait steps the world for the given number of physics steps while every action is released.
+func (w *World) Wait(ticks int) {
+	w.tb.Helper()
+
+	w.Step(ticks, action.States())
+}
+
+// Step steps the world for the given number of physics steps with the given state of the actions. Fails the test if a
+// step fails.
+func (w *World) Step(ticks int, actions map[string]bool) {
+	w.tb.Helper()
+
+	for i := 0; i < ticks; i++ {
+		if err := w.app.Step(actions, w.app.UpdateRate()); err != nil {
+			w.tb.Fatalf("failed to step tick %d: %v", w.ticks, err)
+		}
+
+		w.ticks++
+
+		for _, event := range w.Player().Events {
+			w.events = append(w.events, Event{Tick: w.ticks, Event: event})
+		}
+	}
+}
+
+// Player returns the current state of the player.
+func (w *World) Player() domain.PlayerState {
+	w.tb.Helper()
+
+	players := w.app.Players()
+	if len(players) == 0 {
+		w.tb.Fatal("no player in the game world")
+	}
+
+	return players[0]
+}
+
+// Debug returns the internal state of the behaviours of the player and the camera.
+func (w *World) Debug() (domain.PlayerDebug, domain.CameraDebug) {
+	w.tb.Helper()
+
+	state := w.app.Debug()
+	if len(state.Players) == 0 {
+		w.tb.Fatal("no player in the game world")
+	}
+
+	return state.Players[0], state.Camera
+}
+
+// Events returns the events of the player since the start of the scenario.
+func (w *World) Events() []Event {
+	return w.events
+}
+
+// EventsSince returns the events of the player that occurred after the given physics step.
+func (w *World) EventsSince(tick int) []string {
+	var events []string
+	for _, event := range w.events {
+		if event.Tick > tick && !slices.Contains(events, event.Event) {
+			events = append(events, event.Event)
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,141 @@
+// Package leaderboard implements a file-backed store of verified runs.
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Entry defines the structure of a leaderboard entry.
+type Entry struct {
+	Name          string    `json:"name"`          // Defines the name of the player.
+	ConfigVersion string    `json:"configVersion"` // Defines the version of the configurations the run was played with.
+	Ticks         int       `json:"ticks"`         // Defines the number of physics steps of the run.
+	Time          float64   `json:"time"`          // Defines the completion time of the run in seconds, or the elapsed time if the run was not completed.
+	Height        float64   `json:"height"`        // Defines the maximum height climbed from the spawn position.
+	Level         int       `json:"level"`         // Defines the highest screen level reached.
+	Completed     bool      `json:"completed"`     // Defines if the player reached the goal area of the map.
+	SubmittedAt   time.Time `json:"submittedAt"`   // Defines when the run was submitted.
+}
+
+// Store defines the structure of the leaderboard store, which keeps the entries in a JSON file.
+type Store struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewStore returns a new leaderboard store backed by the file in the specified path. The file is created on the first
+// entry.
+func NewStore(path string) *Store {
+	return &Store{
+		path: path,
+	}
+}
+
+// Add adds the entry to the leaderboard.
+func (s *Store) Add(entry Entry) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	entries = append(entries, entry)
+
+	return s.save(entries)
+}
+
+// Entries returns the ranked entries played with the given configuration version. Completed runs are ranked first by
+// the fastest time, and the other runs are ranked by the highest level, then by the highest height, then by the fastest
+// time.
+func (s *Store) Entries(configVersion string) ([]Entry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.ConfigVersion == configVersion {
+			ranked = append(ranked, entry)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Completed != b.Completed {
+			return a.Completed
+		}
+		if a.Completed {
+			return a.Time < b.Time
+		}
+		if a.Level != b.Level {
+			return a.Level > b.Level
+		}
+		if a.Height != b.Height {
+			return a.Height > b.Height
+		}
+
+		return a.Time < b.Time
+	})
+
+	return ranked, nil
+}
+
+// load reads every entry in the store file.
+func (s *Store) load() ([]Entry, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var entries []Entry
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return entries, nil
+}
+
+// save writes the entries to the store file. The entries are written to a temporary file that then replaces the store
+// file, so that the store is not corrupted by a partial write.
+func (s *Store) save(entries []Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	if err = os.Rename(file.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package race
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/goofr-group/jump-master/engine/internal/websocket"
+)
+
+// Client defines the structure of a race client. It can be used to script clients against a race server.
+type Client struct {
+	conn *websocket.Conn
+	id   int
+}
+
+// Dial connects to the race server in the given ws:// URL and joins the room with the given player name.
+func Dial(ctx context.Context, url, room, name string) (*Client, error) {
+	conn, err := websocket.Dial(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	data, err := json.Marshal(ClientMessage{Type: MessageJoin, Room: room, Name: name})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to marshal join message: %w", err)
+	}
+
+	if err = conn.WriteMessage(data); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join room: %w", err)
+	}
+
+	client := &Client{conn: conn}
+
+	message, err := client.Read(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join room: %w", err)
+	}
+	if message.Type != MessageJoined {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join room: %s", message.Error)
+	}
+
+	client.id = message.ID
+
+	return client, nil
+}
+
+// ID returns the identifier of the player assigned by the server.
+func (c *Client) ID() int {
+	return c.id
+}
+
+// SendActions sends the current state of the player actions.
+func (c *Client) SendActions(actions map[string]bool) error {
+	data, err := json.Marshal(ClientMessage{Type: MessageActions, Actions: actions})
+	if err != nil {
+		return fmt.Errorf("failed to marshal actions message: %w", err)
+	}
+
+	return c.conn.WriteMessage(data)
+}
+
+// Read reads the next message sent by the server. Fails when the deadline of the given context is exceeded, if any.
+func (c *Client) Read(ctx context.Context) (ServerMessage, error) {
+	var message ServerMessage
+
+	deadline, _ := ctx.Deadline()
+	c.conn.SetReadDeadline(deadline)
+
+	data, err := c.conn.ReadMessage()
+	if err != nil {
+		return message, err
+	}
+
+	if err = json.Unmarshal(data, &message); err != nil {
+		return message, fmt.Errorf("failed to unmarshal server message: %w", err)
+	}
+
+	return message, nil
+}
+
+// ReadState reads messages until the next state message sent by the server. Fails when the deadline of the given
+// context is exceeded, if any.
+func (c *Client) ReadState(ctx context.Context) (ServerMessage, error) {
+	for {
+		message, err := c.Read(ctx)
+		if err != nil {
+			return message, err
+		}
+
+		switch message.Type {
+		case MessageState:
+			return message, nil
+		case MessageError:
+			return message, errors.New(message.Error)
+		}
+	}
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,41 @@
+package race
+
+import "github.com/goofr-group/go-math/vector2"
+
+// Message types.
+const (
+	MessageJoin    = "join"    // Sent by the client to join a room.
+	MessageActions = "actions" // Sent by the client with the current state of its actions.
+	MessageJoined  = "joined"  // Sent by the server when the client has joined a room.
+	MessageState   = "state"   // Sent by the server with the state of every player in the room.
+	MessageError   = "error"   // Sent by the server when the client message is invalid.
+)
+
+// ClientMessage defines the structure of the messages sent by the clients.
+type ClientMessage struct {
+	Type    string          `json:"type"`
+	Room    string          `json:"room,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Actions map[string]bool `json:"actions,omitempty"`
+}
+
+// ServerMessage defines the structure of the messages sent by the server.
+type ServerMessage struct {
+	Type    string        `json:"type"`
+	ID      int           `json:"id,omitempty"`
+	Tick    int64         `json:"tick,omitempty"`
+	Players []PlayerState `json:"players,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// PlayerState defines the state of a player in the race.
+type PlayerState struct {
+	ID               int             `json:"id"`
+	Name             string          `json:"name"`
+	Position         vector2.Vector2 `json:"position"`
+	Velocity         vector2.Vector2 `json:"velocity"`
+	Image            string          `json:"image"`
+	FlipHorizontally bool            `json:"flipHorizontally"`
+	Height           float64         `json:"height"`    // Defines the height climbed from the spawn position.
+	MaxHeight        float64         `json:"maxHeight"` // Defines the maximum height climbed from the spawn position.
+}
@@ -0,0 +1,243 @@
+package race
+
+import (
+	"encoding/json"
+	"log"
+	"maps"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/websocket"
+)
+
+// sendBuffer defines the number of messages buffered for each player. A player whose buffer is full is too slow to
+// keep up with the room, and is disconnected.
+const sendBuffer = 64
+
+// player defines the structure of a player connected to a room.
+type player struct {
+	id   int
+	name string
+	conn *websocket.Conn
+	app  *app.App
+
+	send     chan []byte   // Defines the messages to be written to the client.
+	done     chan struct{} // Defines the channel closed when the player is disconnected.
+	kickOnce sync.Once
+
+	actions   map[string]bool // Defines the latest actions received from the client.
+	spawnY    float64         // Defines the spawn position of the player on the y-axis.
+	maxHeight float64         // Defines the maximum height climbed from the spawn position.
+}
+
+// newPlayer returns a new player connected with the given connection, running the given game world.
+func newPlayer(id int, name string, conn *websocket.Conn, a *app.App, spawnY float64) *player {
+	return &player{
+		id:      id,
+		name:    name,
+		conn:    conn,
+		app:     a,
+		send:    make(chan []byte, sendBuffer),
+		done:    make(chan struct{}),
+		actions: make(map[string]bool),
+		spawnY:  spawnY,
+	}
+}
+
+// write writes the queued messages to the client until the player is disconnected, and pings the client so that the
+// connection is kept alive while the client has no actions to send. The player is disconnected when a write fails.
+func (p *player) write() {
+	ticker := time.NewTicker(websocket.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case data := <-p.send:
+			if err := p.conn.WriteMessage(data); err != nil {
+				log.Printf("player %d: failed to write message: %v", p.id, err)
+				p.kick()
+				return
+			}
+
+		case <-ticker.C:
+			if err := p.conn.Ping(); err != nil {
+				log.Printf("player %d: failed to ping: %v", p.id, err)
+				p.kick()
+				return
+			}
+
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// enqueue queues the message to be written to the client without blocking. Returns false if the buffer is full.
+func (p *player) enqueue(data []byte) bool {
+	select {
+	case p.send <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// kick disconnects the player, which stops its writer and makes its reader fail, removing it from the room.
+func (p *player) kick() {
+	p.kickOnce.Do(func() {
+		close(p.done)
+		_ = p.conn.Close()
+	})
+}
+
+// room defines the structure of a race room. Each player runs its own game world, which the room steps with the
+// physics update rate. The mutex guards the players of the room and their actions.
+type room struct {
+	name     string
+	timeStep float64
+
+	mutex   sync.Mutex
+	players map[int]*player
+	tick    int64
+	stop    chan struct{}
+}
+
+// newRoom returns a new room with the given physics update rate.
+func newRoom(name string, timeStep float64) *room {
+	return &room{
+		name:     name,
+		timeStep: timeStep,
+		players:  make(map[int]*player),
+		stop:     make(chan struct{}),
+	}
+}
+
+// run steps the room with the physics update rate until the room is closed.
+func (r *room) run() {
+	ticker := time.NewTicker(time.Duration(r.timeStep * float64(time.Second)))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.step()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// close stops running the room.
+func (r *room) close() {
+	close(r.stop)
+}
+
+// add adds the player to the room.
+func (r *room) add(p *player) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.players[p.id] = p
+}
+
+// remove removes the player from the room and returns the number of remaining players.
+func (r *room) remove(id int) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.players, id)
+
+	return len(r.players)
+}
+
+// setActions updates the latest actions of the player.
+func (r *room) setActions(id int, actions map[string]bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	p, ok := r.players[id]
+	if !ok {
+		return
+	}
+
+	for action, state := range actions {
+		p.actions[action] = state
+	}
+}
+
+// step performs a fixed step of the game world of every player and broadcasts their state. The game worlds are only
+// stepped by the room, so they are stepped outside the lock with a copy of the actions, and the players can join,
+// leave and update their actions meanwhile.
+func (r *room) step() {
+	r.mutex.Lock()
+
+	r.tick++
+	tick := r.tick
+	players := make([]*player, 0, len(r.players))
+	actions := make([]map[string]bool, 0, len(r.players))
+	for _, p := range r.players {
+		players = append(players, p)
+		actions = append(actions, maps.Clone(p.actions))
+	}
+
+	r.mutex.Unlock()
+
+	states := make([]PlayerState, 0, len(players))
+	stepped := make([]*player, 0, len(players))
+
+	for i, p := range players {
+		if err := p.app.Step(actions[i], r.timeStep); err != nil {
+			log.Printf("room %s: failed to step player %d: %v", r.name, p.id, err)
+			continue
+		}
+
+		for _, state := range p.app.Players() {
+			height := state.Position.Y - p.spawnY
+			p.maxHeight = math.Max(p.maxHeight, height)
+
+			states = append(states, PlayerState{
+				ID:               p.id,
+				Name:             p.name,
+				Position:         state.Position,
+				Velocity:         state.Velocity,
+				Image:            state.Image,
+				FlipHorizontally: state.FlipHorizontally,
+				Height:           height,
+				MaxHeight:        p.maxHeight,
+			})
+		}
+
+		stepped = append(stepped, p)
+	}
+
+	data, err := json.Marshal(ServerMessage{
+		Type:    MessageState,
+		Tick:    tick,
+		Players: states,
+	})
+	if err != nil {
+		log.Printf("room %s: failed to marshal state: %v", r.name, err)
+		return
+	}
+
+	// Only broadcast the state to the players still in the room.
+	r.mutex.Lock()
+	recipients := make([]*player, 0, len(stepped))
+	for _, p := range stepped {
+		if _, ok := r.players[p.id]; ok {
+			recipients = append(recipients, p)
+		}
+	}
+	r.mutex.Unlock()
+
+	// The messages are queued, so that slow clients never block the room, and the clients that cannot keep up are
+	// disconnected.
+	for _, p := range recipients {
+		if !p.enqueue(data) {
+			log.Printf("room %s: disconnecting slow player %d", r.name, p.id)
+			p.kick()
+		}
+	}
+}
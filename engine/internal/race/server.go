@@ -0,0 +1,202 @@
+// Package race implements an authoritative race server. Clients join a room and stream their actions, the server
+// simulates the game world of every player with fixed time steps and broadcasts the positions and progress of every
+// player in the room, so that clients can render their opponents as ghosts.
+package race
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/websocket"
+)
+
+// Server defines the structure of the race server.
+type Server struct {
+	engineConfig config.Engine
+	playerConfig config.Player
+	mapConfig    config.Map
+
+	mutex  sync.Mutex
+	rooms  map[string]*room
+	nextID int
+}
+
+// NewServer returns a new race server for the given configurations. Every player runs a single player game world.
+func NewServer(engineConfig config.Engine, playerConfig config.Player, mapConfig config.Map) *Server {
+	engineConfig.Players = nil
+
+	return &Server{
+		engineConfig: engineConfig,
+		playerConfig: playerConfig,
+		mapConfig:    mapConfig,
+		rooms:        make(map[string]*room),
+	}
+}
+
+// ServeHTTP upgrades the connection to the WebSocket protocol and handles the client messages.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Upgrade(w, r)
+	if err != nil {
+		log.Printf("failed to upgrade connection: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	if err = s.handle(conn); err != nil && !errors.Is(err, websocket.ErrClosed) {
+		log.Printf("connection closed: %v", err)
+	}
+}
+
+// Close stops every room of the server.
+func (s *Server) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for name, room := range s.rooms {
+		room.close()
+		delete(s.rooms, name)
+	}
+}
+
+// handle joins the client to a room and updates its actions until the connection is closed.
+func (s *Server) handle(conn *websocket.Conn) error {
+	// Wait for the client to join a room.
+	message, err := readClientMessage(conn)
+	if err != nil {
+		return err
+	}
+	if message.Type != MessageJoin || len(message.Room) == 0 {
+		_ = writeServerMessage(conn, ServerMessage{Type: MessageError, Error: "expected join message with a room"})
+		return errors.New("unexpected join message")
+	}
+
+	// Set up the game world of the player.
+	p, err := s.newPlayer(conn, message.Name)
+	if err != nil {
+		_ = writeServerMessage(conn, ServerMessage{Type: MessageError, Error: "failed to start game world"})
+		return err
+	}
+
+	if err = writeServerMessage(conn, ServerMessage{Type: MessageJoined, ID: p.id}); err != nil {
+		return err
+	}
+
+	// Write the messages of the room to the client until it is disconnected.
+	go p.write()
+	defer p.kick()
+
+	room := s.join(message.Room, p)
+	defer s.leave(message.Room, p.id)
+
+	// Update the actions of the player.
+	for {
+		message, err = readClientMessage(conn)
+		if err != nil {
+			return err
+		}
+
+		if message.Type != MessageActions {
+			if err = sendServerMessage(p, ServerMessage{Type: MessageError, Error: "unexpected message type"}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		room.setActions(p.id, message.Actions)
+	}
+}
+
+// newPlayer returns a new player with its own game world.
+func (s *Server) newPlayer(conn *websocket.Conn, name string) (*player, error) {
+	a := app.New(s.engineConfig, s.playerConfig, s.mapConfig)
+	if err := a.StartGameWorld(); err != nil {
+		return nil, fmt.Errorf("failed to start game world: %w", err)
+	}
+
+	s.mutex.Lock()
+	s.nextID++
+	id := s.nextID
+	s.mutex.Unlock()
+
+	return newPlayer(id, name, conn, a, s.playerConfig.Object.Position.Y), nil
+}
+
+// join adds the player to the room with the given name, creating and running the room if it does not exist.
+func (s *Server) join(name string, p *player) *room {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, ok := s.rooms[name]
+	if !ok {
+		r = newRoom(name, s.engineConfig.Physics.UpdateRate)
+		s.rooms[name] = r
+		go r.run()
+	}
+
+	r.add(p)
+
+	return r
+}
+
+// leave removes the player from the room with the given name, stopping the room when it is empty.
+func (s *Server) leave(name string, id int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, ok := s.rooms[name]
+	if !ok {
+		return
+	}
+
+	if r.remove(id) == 0 {
+		r.close()
+		delete(s.rooms, name)
+	}
+}
+
+// readClientMessage reads and deserializes the next client message.
+func readClientMessage(conn *websocket.Conn) (ClientMessage, error) {
+	var message ClientMessage
+
+	data, err := conn.ReadMessage()
+	if err != nil {
+		return message, err
+	}
+
+	if err = json.Unmarshal(data, &message); err != nil {
+		return message, fmt.Errorf("failed to unmarshal client message: %w", err)
+	}
+
+	return message, nil
+}
+
+// writeServerMessage serializes and writes the server message.
+func writeServerMessage(conn *websocket.Conn, message ServerMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal server message: %w", err)
+	}
+
+	return conn.WriteMessage(data)
+}
+
+// sendServerMessage serializes and queues the server message to be written to the player. Returns an error if the
+// buffer of the player is full.
+func sendServerMessage(p *player, message ServerMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal server message: %w", err)
+	}
+
+	if !p.enqueue(data) {
+		return errors.New("send buffer full")
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package race_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+	"github.com/goofr-group/jump-master/engine/internal/race"
+)
+
+// newServer starts a race server with the game configurations and returns its ws:// URL. Fails the test if the
+// configurations cannot be loaded.
+func newServer(t *testing.T) string {
+	t.Helper()
+
+	engineConfig, playerConfig, mapConfig := gametest.Configs(t)
+
+	server := race.NewServer(engineConfig, playerConfig, mapConfig)
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Close()
+	})
+
+	return "ws" + strings.TrimPrefix(httpServer.URL, "http")
+}
+
+// dial connects a scripted client to the room of the race server. Fails the test if it cannot join.
+func dial(ctx context.Context, t *testing.T, url, name string) *race.Client {
+	t.Helper()
+
+	client, err := race.Dial(ctx, url, "room", name)
+	if err != nil {
+		t.Fatalf("%s: failed to join room: %v", name, err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+// readStates reads the given number of state messages from every given client, one client after the other so that
+// none of them falls behind the room, and returns the last message of the last client. Fails the test if a message
+// cannot be read before the deadline of the given context.
+func readStates(ctx context.Context, t *testing.T, n int, clients ...*race.Client) race.ServerMessage {
+	t.Helper()
+
+	var message race.ServerMessage
+	for i := 0; i < n; i++ {
+		for _, client := range clients {
+			var err error
+			if message, err = client.ReadState(ctx); err != nil {
+				t.Fatalf("player %d: failed to read state: %v", client.ID(), err)
+			}
+		}
+	}
+
+	return message
+}
+
+// playerState returns the state of the player with the given identifier in the state message. Fails the test if the
+// player is not in the room.
+func playerState(t *testing.T, message race.ServerMessage, id int) race.PlayerState {
+	t.Helper()
+
+	for _, state := range message.Players {
+		if state.ID == id {
+			return state
+		}
+	}
+
+	t.Fatalf("tick %d: player %d not in the room", message.Tick, id)
+	return race.PlayerState{}
+}
+
+func TestRace(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	url := newServer(t)
+	alice := dial(ctx, t, url, "alice")
+	bob := dial(ctx, t, url, "bob")
+
+	if alice.ID() == bob.ID() {
+		t.Fatalf("expected different player identifiers, got %d", alice.ID())
+	}
+
+	// Let both players land before moving.
+	start := readStates(ctx, t, 75, alice, bob)
+	aliceStart := playerState(t, start, alice.ID())
+	bobStart := playerState(t, start, bob.ID())
+
+	if aliceStart.Name != "alice" || bobStart.Name != "bob" {
+		t.Errorf("expected the player names, got %q and %q", aliceStart.Name, bobStart.Name)
+	}
+
+	// Move the first player to the right while the second one stands still.
+	if err := alice.SendActions(map[string]bool{action.Right: true}); err != nil {
+		t.Fatalf("failed to send actions: %v", err)
+	}
+
+	// Both clients receive the state of both players.
+	end := readStates(ctx, t, 40, alice, bob)
+
+	if x := playerState(t, end, alice.ID()).Position.X; x <= aliceStart.Position.X {
+		t.Errorf("expected the first player to move right from %v, got %v", aliceStart.Position.X, x)
+	}
+	if x := playerState(t, end, bob.ID()).Position.X; x != bobStart.Position.X {
+		t.Errorf("expected the second player to stand still at %v, got %v", bobStart.Position.X, x)
+	}
+
+	// The room keeps running for the remaining player after the other one leaves.
+	if err := alice.Close(); err != nil {
+		t.Fatalf("failed to close client: %v", err)
+	}
+
+	for {
+		message := readStates(ctx, t, 1, bob)
+		if len(message.Players) == 1 {
+			playerState(t, message, bob.ID())
+			break
+		}
+	}
+}
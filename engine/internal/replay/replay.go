@@ -0,0 +1,100 @@
+// Package replay defines the input replays of a run, which can be simulated deterministically by stepping the game
+// world with the physics update rate.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/goofr-group/go-math/vector2"
+)
+
+// Input defines the state of the actions held during a number of consecutive physics steps.
+type Input struct {
+	Ticks   int             `json:"ticks"`   // Defines the number of physics steps the actions are held.
+	Actions map[string]bool `json:"actions"` // Defines the state of the actions.
+}
+
+// Result defines the final state of a run.
+type Result struct {
+	Ticks     int             `json:"ticks"`     // Defines the number of physics steps of the run.
+	Time      float64         `json:"time"`      // Defines the completion time of the run in seconds, or the elapsed time if the run was not completed.
+	Height    float64         `json:"height"`    // Defines the maximum height climbed from the spawn position.
+	Level     int             `json:"level"`     // Defines the highest screen level reached.
+	Position  vector2.Vector2 `json:"position"`  // Defines the final position of the player.
+	Completed bool            `json:"completed"` // Defines if the player reached the goal area of the map.
+}
+
+// Replay defines the structure of an input replay.
+type Replay struct {
+	ConfigVersion string  `json:"configVersion"` // Defines the version of the configurations the run was played with.
+	Inputs        []Input `json:"inputs"`        // Defines the inputs of every physics step of the run.
+	Result        Result  `json:"result"`        // Defines the claimed final state of the run.
+}
+
+// Ticks returns the number of physics steps of the replay inputs.
+func (r Replay) Ticks() int {
+	var ticks int
+	for _, input := range r.Inputs {
+		ticks += input.Ticks
+	}
+
+	return ticks
+}
+
+// Load reads the replay in the specified path.
+func Load(path string) (Replay, error) {
+	var r Replay
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return r, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	err = json.Unmarshal(data, &r)
+	if err != nil {
+		return r, fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return r, nil
+}
+
+// Save writes the replay to the specified path.
+func Save(path string, r Replay) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	err = os.WriteFile(path, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// Recorder records the actions of every physics step, merging consecutive steps with the same actions.
+type Recorder struct {
+	inputs []Input
+}
+
+// Record records the state of the actions of a physics step.
+func (r *Recorder) Record(actions map[string]bool) {
+	if n := len(r.inputs); n > 0 && maps.Equal(r.inputs[n-1].Actions, actions) {
+		r.inputs[n-1].Ticks++
+		return
+	}
+
+	r.inputs = append(r.inputs, Input{
+		Ticks:   1,
+		Actions: maps.Clone(actions),
+	})
+}
+
+// Inputs returns the recorded inputs.
+func (r Recorder) Inputs() []Input {
+	return r.inputs
+}
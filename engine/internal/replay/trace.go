@@ -0,0 +1,42 @@
+package replay
+
+import (
+	"math"
+
+	"github.com/goofr-group/go-math/vector2"
+)
+
+// Frame defines the state of a player rendered in a physics step.
+type Frame struct {
+	Position         vector2.Vector2 `json:"position"`         // Defines the position of the player.
+	Image            string          `json:"image"`            // Defines the image of the player.
+	FlipHorizontally bool            `json:"flipHorizontally"` // Defines whether the player is flipped horizontally or not.
+}
+
+// Trace defines the structure of a position trace, which records the frames of a run to be rendered as a ghost.
+type Trace struct {
+	Name       string  `json:"name"`       // Defines the name of the run.
+	UpdateRate float64 `json:"updateRate"` // Defines the time between frames in seconds.
+	Frames     []Frame `json:"frames"`     // Defines the frames of every physics step of the run.
+}
+
+// Frame returns the frame of the trace at the given elapsed time in seconds. The first frame is the state after the
+// first physics step, so that the frame at the elapsed time of a run matches the state of the player at that time.
+// Before the first frame, the first frame is returned, and after the end of the trace, the last frame is returned.
+// Returns false if the trace has no frames.
+func (t Trace) Frame(elapsed float64) (Frame, bool) {
+	if len(t.Frames) == 0 {
+		return Frame{}, false
+	}
+
+	var index int
+	if t.UpdateRate > 0 {
+		// Round the number of physics steps, to avoid rounding down when the elapsed time is a multiple of the update
+		// rate.
+		index = int(math.Round(elapsed/t.UpdateRate)) - 1
+	}
+
+	index = min(max(index, 0), len(t.Frames)-1)
+
+	return t.Frames[index], true
+}
@@ -0,0 +1,171 @@
+// Package spritefusion converts the map configuration to the project format of the Sprite Fusion editor, where the map
+// of the game is designed.
+package spritefusion
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/goofr-group/jump-master/engine"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+)
+
+// pathProject defines the path of the Sprite Fusion project of the game map.
+const pathProject = "configs/spritefusion/Jump_Master.json"
+
+// Tile defines the structure of a Sprite Fusion tile.
+type Tile struct {
+	ID            string  `json:"id"`            // Defines the identifier of the sprite in the sprite sheet.
+	X             int     `json:"x"`             // Defines the position on the x-axis of the tile in pixels.
+	Y             int     `json:"y"`             // Defines the position on the y-axis of the tile in pixels.
+	SpriteSheetID string  `json:"spriteSheetId"` // Defines the identifier of the sprite sheet.
+	ScaleX        float64 `json:"scaleX"`
+}
+
+// Layer defines the structure of a Sprite Fusion layer. The auto-tiling settings are kept as they are.
+type Layer struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Tiles       []Tile          `json:"tiles"`
+	Collider    bool            `json:"collider"`
+	DefaultTile json.RawMessage `json:"defaultTile,omitempty"`
+	Rules       json.RawMessage `json:"rules,omitempty"`
+}
+
+// Project defines the structure of a Sprite Fusion project.
+type Project struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	TileSize     int               `json:"tileSize"`
+	MapWidth     int               `json:"mapWidth"`  // Defines the width of the map in pixels.
+	MapHeight    int               `json:"mapHeight"` // Defines the height of the map in pixels.
+	SpriteSheets map[string]string `json:"spriteSheets"`
+	Layers       []Layer           `json:"layers"`
+	Settings     json.RawMessage   `json:"settings,omitempty"`
+}
+
+// sprite defines a sprite of a sprite sheet.
+type sprite struct {
+	id            string
+	spriteSheetID string
+}
+
+// Load loads the Sprite Fusion project of the game map.
+func Load() (Project, error) {
+	var project Project
+
+	data, err := engine.ConfigsFS.ReadFile(pathProject)
+	if err != nil {
+		return project, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	err = json.Unmarshal(data, &project)
+	if err != nil {
+		return project, fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return project, nil
+}
+
+// Export returns the given base project with the layers of the given map configuration. The base project defines the
+// sprite sheets, the settings of the layers with the same name and the mapping between the tile identifiers of the map
+// configuration and the sprites: in the same way as the Sprite Fusion map export, the identifiers are assigned to the
+// sprites in the order they first appear in the layers, which is why the project must start with the "Tileset" layer.
+// The tiles keep the origin of the base project.
+func Export(base Project, m config.Map) (Project, error) {
+	// Get the sprites by tile identifier and the origin of the base project, in tiles.
+	var sprites []sprite
+	spriteIndex := make(map[sprite]struct{})
+	originX, originY := math.MaxInt, math.MaxInt
+
+	baseLayers := make(map[string]Layer, len(base.Layers))
+	for _, layer := range base.Layers {
+		baseLayers[layer.Name] = layer
+
+		for _, tile := range layer.Tiles {
+			s := sprite{id: tile.ID, spriteSheetID: tile.SpriteSheetID}
+			if _, ok := spriteIndex[s]; !ok {
+				spriteIndex[s] = struct{}{}
+				sprites = append(sprites, s)
+			}
+
+			if base.TileSize > 0 {
+				originX = min(originX, floorDiv(tile.X, base.TileSize))
+				originY = min(originY, floorDiv(tile.Y, base.TileSize))
+			}
+		}
+	}
+
+	if originX == math.MaxInt {
+		originX, originY = 0, 0
+	}
+
+	project := base
+	project.TileSize = m.TileSize
+	project.MapWidth = m.Width * m.TileSize
+	project.MapHeight = m.Height * m.TileSize
+	project.Layers = make([]Layer, 0, len(m.Layers))
+
+	for _, mapLayer := range m.Layers {
+		layer, ok := baseLayers[mapLayer.Name]
+		if !ok {
+			id, err := newID()
+			if err != nil {
+				return Project{}, fmt.Errorf("failed to generate layer id: %w", err)
+			}
+
+			layer = Layer{ID: id, Name: mapLayer.Name}
+		}
+
+		layer.Collider = mapLayer.Collider
+		layer.Tiles = make([]Tile, 0, len(mapLayer.Tiles))
+
+		for _, tile := range mapLayer.Tiles {
+			index, err := strconv.Atoi(tile.ID)
+			if err != nil || index < 0 || index >= len(sprites) {
+				return Project{}, fmt.Errorf("tile id %q of layer %q not found in the base project", tile.ID, mapLayer.Name)
+			}
+
+			layer.Tiles = append(layer.Tiles, Tile{
+				ID:            sprites[index].id,
+				X:             (tile.X + originX) * m.TileSize,
+				Y:             (tile.Y + originY) * m.TileSize,
+				SpriteSheetID: sprites[index].spriteSheetID,
+				ScaleX:        1,
+			})
+		}
+
+		project.Layers = append(project.Layers, layer)
+	}
+
+	return project, nil
+}
+
+// floorDiv returns the quotient of the given values rounded down.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+
+	return q
+}
+
+// newID returns a new random identifier in the UUID format used by Sprite Fusion.
+func newID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+
+	// Set the version 4 and variant bits.
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,173 @@
+// Package verifier re-simulates input replays headlessly to confirm the results claimed by the players.
+package verifier
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/goofr-group/jump-master/engine/internal/app"
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/domain"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+)
+
+const (
+	tolerance   = 1e-6        // Defines the tolerance used to compare the claimed and simulated floating point values.
+	maxDuration = 2 * 60 * 60 // Defines the maximum duration, in seconds, of the simulated runs.
+)
+
+var (
+	// ErrConfigVersion is returned when the replay was played with a different configuration version.
+	ErrConfigVersion = errors.New("config version mismatch")
+	// ErrDiverged is returned when the simulated final state diverges from the claimed result.
+	ErrDiverged = errors.New("simulated state diverged from the claimed result")
+	// ErrInvalidInputs is returned when the replay inputs cannot be simulated, before any physics step is simulated.
+	ErrInvalidInputs = errors.New("invalid replay inputs")
+)
+
+// Verifier defines the structure of the replay verifier.
+type Verifier struct {
+	engineConfig  config.Engine
+	playerConfig  config.Player
+	mapConfig     config.Map
+	configVersion string
+}
+
+// New returns a new verifier for the given configurations and their version. Replays are simulated with a single
+// player using the actions without a namespace.
+func New(engineConfig config.Engine, playerConfig config.Player, mapConfig config.Map, configVersion string) Verifier {
+	engineConfig.Players = nil
+
+	return Verifier{
+		engineConfig:  engineConfig,
+		playerConfig:  playerConfig,
+		mapConfig:     mapConfig,
+		configVersion: configVersion,
+	}
+}
+
+// Simulate steps a new game world with the given inputs and returns its final state.
+func (v Verifier) Simulate(inputs []replay.Input) (replay.Result, error) {
+	return v.simulate(inputs, nil)
+}
+
+// Trace steps a new game world with the given inputs and returns the frames of the player, to be rendered as a ghost
+// run with the given name.
+func (v Verifier) Trace(name string, inputs []replay.Input) (replay.Trace, error) {
+	trace := replay.Trace{
+		Name:       name,
+		UpdateRate: v.engineConfig.Physics.UpdateRate,
+	}
+
+	_, err := v.simulate(inputs, func(player domain.PlayerState) {
+		trace.Frames = append(trace.Frames, replay.Frame{
+			Position:         player.Position,
+			Image:            player.Image,
+			FlipHorizontally: player.FlipHorizontally,
+		})
+	})
+	if err != nil {
+		return trace, err
+	}
+
+	return trace, nil
+}
+
+// simulate steps a new game world with the given inputs and returns its final state. If defined, the step callback is
+// called with the state of the player after every physics step.
+func (v Verifier) simulate(inputs []replay.Input, onStep func(player domain.PlayerState)) (replay.Result, error) {
+	if _, err := v.ticks(inputs); err != nil {
+		return replay.Result{}, err
+	}
+
+	a := app.New(v.engineConfig, v.playerConfig, v.mapConfig)
+	if err := a.StartGameWorld(); err != nil {
+		return replay.Result{}, fmt.Errorf("failed to start game world: %w", err)
+	}
+
+	timeStep := a.UpdateRate()
+
+	for _, input := range inputs {
+		for i := 0; i < input.Ticks; i++ {
+			if err := a.Step(input.Actions, timeStep); err != nil {
+				return a.Result(), fmt.Errorf("failed to step tick %d: %w", a.Result().Ticks, err)
+			}
+
+			if onStep != nil {
+				for _, player := range a.Players() {
+					onStep(player)
+				}
+			}
+		}
+	}
+
+	return a.Result(), nil
+}
+
+// Verify simulates the replay and confirms that the final state matches the claimed result. Returns the simulated
+// result.
+func (v Verifier) Verify(r replay.Replay) (replay.Result, error) {
+	if r.ConfigVersion != v.configVersion {
+		return replay.Result{}, fmt.Errorf("%w: replay %q, verifier %q", ErrConfigVersion, r.ConfigVersion, v.configVersion)
+	}
+
+	// Check the length of the run before simulating it, so that the claimed result bounds the simulation.
+	claim := r.Result
+	ticks, err := v.ticks(r.Inputs)
+	if err != nil {
+		return replay.Result{}, err
+	}
+	if ticks != claim.Ticks {
+		return replay.Result{}, fmt.Errorf("%w: claimed %d ticks, inputs of %d ticks", ErrInvalidInputs, claim.Ticks, ticks)
+	}
+
+	result, err := v.Simulate(r.Inputs)
+	if err != nil {
+		return result, err
+	}
+
+	switch {
+	case claim.Ticks != result.Ticks:
+		return result, fmt.Errorf("%w: claimed %d ticks, simulated %d", ErrDiverged, claim.Ticks, result.Ticks)
+	case !approximately(claim.Time, result.Time):
+		return result, fmt.Errorf("%w: claimed time %f, simulated %f", ErrDiverged, claim.Time, result.Time)
+	case !approximately(claim.Height, result.Height):
+		return result, fmt.Errorf("%w: claimed height %f, simulated %f", ErrDiverged, claim.Height, result.Height)
+	case claim.Completed != result.Completed:
+		return result, fmt.Errorf("%w: claimed completed %t, simulated %t", ErrDiverged, claim.Completed, result.Completed)
+	case claim.Level != result.Level:
+		return result, fmt.Errorf("%w: claimed level %d, simulated %d", ErrDiverged, claim.Level, result.Level)
+	case !approximately(claim.Position.X, result.Position.X) || !approximately(claim.Position.Y, result.Position.Y):
+		return result, fmt.Errorf("%w: claimed position %v, simulated %v", ErrDiverged, claim.Position, result.Position)
+	}
+
+	return result, nil
+}
+
+// ticks returns the number of physics steps of the given inputs. Returns an error if an input does not have a positive
+// number of physics steps, or if the run is longer than the maximum duration.
+func (v Verifier) ticks(inputs []replay.Input) (int, error) {
+	maxTicks := int(maxDuration / v.engineConfig.Physics.UpdateRate)
+
+	var ticks int
+	for i, input := range inputs {
+		if input.Ticks <= 0 {
+			return 0, fmt.Errorf("%w: input %d has %d ticks", ErrInvalidInputs, i, input.Ticks)
+		}
+
+		// The inputs are checked one by one, so that the sum of the physics steps cannot overflow.
+		if input.Ticks > maxTicks-ticks {
+			return 0, fmt.Errorf("%w: run longer than %d ticks", ErrInvalidInputs, maxTicks)
+		}
+
+		ticks += input.Ticks
+	}
+
+	return ticks, nil
+}
+
+// approximately returns true if the given values are equal within the tolerance.
+func approximately(a, b float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
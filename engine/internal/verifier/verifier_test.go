@@ -0,0 +1,213 @@
+package verifier_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goofr-group/jump-master/engine/internal/config"
+	"github.com/goofr-group/jump-master/engine/internal/game/action"
+	"github.com/goofr-group/jump-master/engine/internal/gametest"
+	"github.com/goofr-group/jump-master/engine/internal/replay"
+	"github.com/goofr-group/jump-master/engine/internal/verifier"
+)
+
+// configVersion defines the configuration version of the replays in the tests.
+const configVersion = "test"
+
+// newVerifier returns a new verifier with the game configurations. Fails the test if they cannot be loaded.
+func newVerifier(t *testing.T) verifier.Verifier {
+	t.Helper()
+
+	engineConfig, playerConfig, mapConfig := gametest.Configs(t)
+
+	return verifier.New(engineConfig, playerConfig, mapConfig, configVersion)
+}
+
+// recordReplay plays the given inputs in a game world and returns the replay recorded by the game steps. Fails the
+// test if the run is not recordable.
+func recordReplay(t *testing.T, inputs []replay.Input) replay.Replay {
+	t.Helper()
+
+	w := gametest.New(t)
+	w.Run(inputs...)
+
+	r, err := w.App().Replay(configVersion)
+	if err != nil {
+		t.Fatalf("failed to record replay: %v", err)
+	}
+
+	return r
+}
+
+// jumpInputs returns the inputs of a short run with a diagonal jump.
+func jumpInputs() []replay.Input {
+	return []replay.Input{
+		{Ticks: 75, Actions: action.States()},
+		{Ticks: 20, Actions: action.States(action.Jump, action.Right)},
+		{Ticks: 150, Actions: action.States()},
+	}
+}
+
+func TestTraceMatchesReplay(t *testing.T) {
+	inputs := jumpInputs()
+
+	trace, err := newVerifier(t).Trace("run", inputs)
+	if err != nil {
+		t.Fatalf("failed to trace the replay: %v", err)
+	}
+
+	// Replay the inputs and compare the player with the frame of the trace at the elapsed time of every physics step.
+	w := gametest.New(t)
+	for _, input := range inputs {
+		for i := 0; i < input.Ticks; i++ {
+			w.Step(1, input.Actions)
+
+			frame, ok := trace.Frame(float64(w.Ticks()) * trace.UpdateRate)
+			if !ok {
+				t.Fatal("expected the trace to have frames")
+			}
+
+			if position := w.Player().Position; frame.Position != position {
+				t.Fatalf("tick %d: expected the trace frame in %v, got %v", w.Ticks(), position, frame.Position)
+			}
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	v := newVerifier(t)
+	inputs := jumpInputs()
+
+	// The replay recorded by the game steps is verified.
+	r := recordReplay(t, inputs)
+	if ticks := r.Ticks(); ticks != 245 {
+		t.Fatalf("expected 245 recorded ticks, got %d", ticks)
+	}
+	if r.Result.Height <= 0 {
+		t.Errorf("expected the jump to climb, got height %v", r.Result.Height)
+	}
+
+	if _, err := v.Verify(r); err != nil {
+		t.Fatalf("expected the recorded replay to be verified, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		tamper   func(r *replay.Replay)
+		expected error
+	}{
+		{
+			name: "config version",
+			tamper: func(r *replay.Replay) {
+				r.ConfigVersion = "other"
+			},
+			expected: verifier.ErrConfigVersion,
+		},
+		{
+			name: "inputs",
+			tamper: func(r *replay.Replay) {
+				r.Inputs = []replay.Input{
+					{Ticks: 75, Actions: action.States()},
+					{Ticks: 20, Actions: action.States(action.Jump, action.Left)},
+					{Ticks: 150, Actions: action.States()},
+				}
+			},
+			expected: verifier.ErrDiverged,
+		},
+		{
+			name: "ticks",
+			tamper: func(r *replay.Replay) {
+				r.Result.Ticks--
+			},
+			expected: verifier.ErrInvalidInputs,
+		},
+		{
+			name: "zero input ticks",
+			tamper: func(r *replay.Replay) {
+				r.Inputs = append(r.Inputs, replay.Input{Ticks: 0, Actions: action.States(action.Jump)})
+			},
+			expected: verifier.ErrInvalidInputs,
+		},
+		{
+			name: "negative input ticks",
+			tamper: func(r *replay.Replay) {
+				r.Inputs = append(r.Inputs,
+					replay.Input{Ticks: 1000, Actions: action.States(action.Right)},
+					replay.Input{Ticks: -1000, Actions: action.States()},
+				)
+			},
+			expected: verifier.ErrInvalidInputs,
+		},
+		{
+			name: "run too long",
+			tamper: func(r *replay.Replay) {
+				r.Inputs = []replay.Input{{Ticks: 1_000_000_000, Actions: action.States()}}
+				r.Result.Ticks = 1_000_000_000
+			},
+			expected: verifier.ErrInvalidInputs,
+		},
+		{
+			name: "height",
+			tamper: func(r *replay.Replay) {
+				r.Result.Height += 48
+			},
+			expected: verifier.ErrDiverged,
+		},
+		{
+			name: "completed",
+			tamper: func(r *replay.Replay) {
+				r.Result.Completed = true
+			},
+			expected: verifier.ErrDiverged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tampered := recordReplay(t, inputs)
+			tt.tamper(&tampered)
+
+			if _, err := v.Verify(tampered); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestVerifyCompletedRun(t *testing.T) {
+	engineConfig, playerConfig, mapConfig := gametest.Configs(t)
+
+	// Use the whole map as the goal area, so that the run is completed in the first physics step.
+	mapConfig.Goal = config.Goal{Width: mapConfig.Width, Height: mapConfig.Height}
+
+	w := gametest.New(t, gametest.WithMap(mapConfig))
+	w.Run(jumpInputs()...)
+
+	r, err := w.App().Replay(configVersion)
+	if err != nil {
+		t.Fatalf("failed to record replay: %v", err)
+	}
+
+	// The time of the run stops once it is completed, while the physics steps keep being recorded.
+	if !r.Result.Completed {
+		t.Fatal("expected the run to be completed")
+	}
+	if r.Result.Ticks != 245 {
+		t.Errorf("expected 245 ticks, got %d", r.Result.Ticks)
+	}
+	if rate := engineConfig.Physics.UpdateRate; r.Result.Time != rate {
+		t.Errorf("expected the completion time %v, got %v", rate, r.Result.Time)
+	}
+
+	v := verifier.New(engineConfig, playerConfig, mapConfig, configVersion)
+	if _, err = v.Verify(r); err != nil {
+		t.Fatalf("expected the completed run to be verified, got %v", err)
+	}
+
+	// The same run is not completed without a goal area.
+	mapConfig.Goal = config.Goal{}
+	v = verifier.New(engineConfig, playerConfig, mapConfig, configVersion)
+	if _, err = v.Verify(r); !errors.Is(err, verifier.ErrDiverged) {
+		t.Errorf("expected %v, got %v", verifier.ErrDiverged, err)
+	}
+}
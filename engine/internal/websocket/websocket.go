@@ -0,0 +1,436 @@
+// Package websocket implements the subset of the WebSocket protocol (RFC 6455) used by the race server and its
+// clients: the opening handshake and unfragmented or fragmented text messages, answering pings and close frames.
+// The reads fail when the other endpoint is silent for longer than the read timeout, so the endpoints that do not send
+// data messages regularly must ping the other endpoint.
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Frame opcodes.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+const (
+	// acceptGUID defines the GUID used to compute the accept key of the opening handshake.
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	// maxMessageSize defines the maximum size in bytes of a message.
+	maxMessageSize = 1 << 20
+
+	// maxControlPayload defines the maximum size in bytes of the payload of a control frame (RFC 6455 5.5).
+	maxControlPayload = 125
+
+	// readTimeout defines the maximum duration without receiving a frame, so that a silent endpoint does not hold
+	// the reader indefinitely.
+	readTimeout = 60 * time.Second
+
+	// writeTimeout defines the maximum duration of a frame write, so that an unresponsive endpoint does not block
+	// the writer indefinitely.
+	writeTimeout = 10 * time.Second
+
+	// PingInterval defines the interval of the pings that keep alive a connection without data messages, shorter than
+	// the read timeout of the other endpoint.
+	PingInterval = readTimeout / 3
+
+	// version defines the supported version of the WebSocket protocol.
+	version = "13"
+
+	// closeProtocolError defines the status code of the close frame sent when the other endpoint violates the
+	// protocol.
+	closeProtocolError = 1002
+)
+
+var (
+	// ErrClosed is returned when the connection was closed by the other endpoint.
+	ErrClosed = errors.New("connection closed")
+	// ErrMessageTooLarge is returned when a message exceeds the maximum size.
+	ErrMessageTooLarge = errors.New("message too large")
+	// ErrProtocol is returned when the other endpoint violates the protocol.
+	ErrProtocol = errors.New("protocol error")
+)
+
+// Conn defines a WebSocket connection.
+type Conn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+	client bool // Defines if the connection is the client endpoint, which must mask the frames it sends.
+
+	readDeadline time.Time // Defines the deadline of the reads set by the reader, if any.
+
+	writeMutex sync.Mutex
+}
+
+// Upgrade upgrades the HTTP server connection to the WebSocket protocol.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "websocket upgrade required", http.StatusBadRequest)
+		return nil, errors.New("missing upgrade headers")
+	}
+
+	if r.Header.Get("Sec-WebSocket-Version") != version {
+		w.Header().Set("Sec-WebSocket-Version", version)
+		http.Error(w, "unsupported websocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("unsupported websocket version")
+	}
+
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if len(key) == 0 {
+		http.Error(w, "missing websocket key", http.StatusBadRequest)
+		return nil, errors.New("missing websocket key")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket not supported", http.StatusInternalServerError)
+		return nil, errors.New("response writer does not support hijacking")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hijack connection: %w", err)
+	}
+
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+
+	if _, err = rw.WriteString(response); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write handshake: %w", err)
+	}
+	if err = rw.Flush(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write handshake: %w", err)
+	}
+
+	return &Conn{
+		conn:   conn,
+		reader: rw.Reader,
+		writer: rw.Writer,
+	}, nil
+}
+
+// Dial opens a WebSocket client connection to the given ws:// URL.
+func Dial(ctx context.Context, rawURL string) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url: %w", err)
+	}
+	if u.Scheme != "ws" {
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial: %w", err)
+	}
+
+	// Bound the opening handshake with the deadline of the context, if any.
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set handshake deadline: %w", err)
+	}
+
+	// Generate the handshake key.
+	nonce := make([]byte, 16)
+	if _, err = rand.Read(nonce); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	request := "GET " + u.RequestURI() + " HTTP/1.1\r\n" +
+		"Host: " + u.Host + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + key + "\r\n" +
+		"Sec-WebSocket-Version: " + version + "\r\n\r\n"
+
+	if _, err = io.WriteString(conn, request); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write handshake: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, &http.Request{Method: http.MethodGet})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read handshake: %w", err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected handshake status %d", response.StatusCode)
+	}
+	if response.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, errors.New("unexpected handshake accept key")
+	}
+
+	// Remove the handshake deadline, since the reads and writes set their own deadlines.
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to reset handshake deadline: %w", err)
+	}
+
+	return &Conn{
+		conn:   conn,
+		reader: reader,
+		writer: bufio.NewWriter(conn),
+		client: true,
+	}, nil
+}
+
+// ReadMessage reads the next data message. Control frames received in the meantime are answered. Returns ErrClosed
+// when the other endpoint closes the connection. Fails if no frame is received within the read timeout, or after the
+// read deadline.
+func (c *Conn) ReadMessage() ([]byte, error) {
+	var message []byte
+	fragmented := false // Defines if a fragmented message is being read.
+
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err = c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+
+		case opPong:
+			continue
+
+		case opClose:
+			_ = c.writeFrame(opClose, nil)
+			return nil, ErrClosed
+
+		case opText, opBinary, opContinuation:
+			// A message starts with a text or binary frame, and its fragments are continuation frames (RFC 6455 5.4).
+			if fragmented != (opcode == opContinuation) {
+				return nil, c.protocolError("unexpected data frame with opcode %d", opcode)
+			}
+
+			if len(message)+len(payload) > maxMessageSize {
+				return nil, ErrMessageTooLarge
+			}
+			message = append(message, payload...)
+			fragmented = !fin
+
+		default:
+			return nil, c.protocolError("unexpected opcode %d", opcode)
+		}
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// SetReadDeadline sets the deadline of the reads of the next messages. A zero value removes the deadline, so that the
+// reads only fail after the read timeout. It must be called by the reader of the connection.
+func (c *Conn) SetReadDeadline(deadline time.Time) {
+	c.readDeadline = deadline
+}
+
+// Ping writes a ping frame, which the other endpoint answers while it reads the messages.
+func (c *Conn) Ping() error {
+	return c.writeFrame(opPing, nil)
+}
+
+// WriteMessage writes the given data as a text message.
+func (c *Conn) WriteMessage(data []byte) error {
+	return c.writeFrame(opText, data)
+}
+
+// Close sends a close frame and closes the underlying connection.
+func (c *Conn) Close() error {
+	_ = c.writeFrame(opClose, nil)
+	return c.conn.Close()
+}
+
+// readFrame reads a single frame and returns its final flag, opcode and unmasked payload. Fails if the frame is not
+// received within the read timeout, or after the read deadline.
+func (c *Conn) readFrame() (bool, byte, []byte, error) {
+	deadline := time.Now().Add(readTimeout)
+	if !c.readDeadline.IsZero() && c.readDeadline.Before(deadline) {
+		deadline = c.readDeadline
+	}
+	if err := c.conn.SetReadDeadline(deadline); err != nil {
+		return false, 0, nil, err
+	}
+
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+
+	// The frames sent by the client must be masked, and the frames sent by the server must not (RFC 6455 5.1).
+	if masked == c.client {
+		return false, 0, nil, c.protocolError("unexpected frame masking")
+	}
+
+	// Read the payload length.
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+
+	// The control frames must not be fragmented, and their payload must fit in a single byte length (RFC 6455 5.5).
+	if opcode&0x8 != 0 && (!fin || length > maxControlPayload) {
+		return false, 0, nil, c.protocolError("invalid control frame with opcode %d", opcode)
+	}
+
+	if length > maxMessageSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	// Read the masking key.
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	// Read the payload.
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// protocolError sends a close frame with the protocol error status, and returns the protocol error with the given
+// formatted details.
+func (c *Conn) protocolError(format string, args ...any) error {
+	_ = c.writeFrame(opClose, binary.BigEndian.AppendUint16(nil, closeProtocolError))
+	return fmt.Errorf("%w: %s", ErrProtocol, fmt.Sprintf(format, args...))
+}
+
+// writeFrame writes a single final frame with the given opcode and payload. Client frames are masked. Fails if the
+// frame cannot be written within the write timeout.
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	var maskBit byte
+	if c.client {
+		maskBit = 0x80
+	}
+
+	// Write the header with the payload length.
+	header := []byte{0x80 | opcode}
+	switch length := len(payload); {
+	case length < 126:
+		header = append(header, maskBit|byte(length))
+
+	case length <= 0xffff:
+		header = append(header, maskBit|126)
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+
+	default:
+		header = append(header, maskBit|127)
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	// Mask the payload.
+	if c.client {
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return fmt.Errorf("failed to generate mask: %w", err)
+		}
+		header = append(header, mask[:]...)
+
+		masked := make([]byte, len(payload))
+		for i := range payload {
+			masked[i] = payload[i] ^ mask[i%4]
+		}
+		payload = masked
+	}
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+
+	if _, err := c.writer.Write(header); err != nil {
+		return err
+	}
+	if _, err := c.writer.Write(payload); err != nil {
+		return err
+	}
+
+	return c.writer.Flush()
+}
+
+// acceptKey returns the accept key of the opening handshake for the given client key.
+func acceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// headerContains returns true if the comma separated values of the given header contain the value, ignoring case.
+func headerContains(header http.Header, name, value string) bool {
+	for _, v := range header.Values(name) {
+		for _, token := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,232 @@
+package websocket_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/goofr-group/jump-master/engine/internal/websocket"
+)
+
+// newServer starts a server that upgrades the connections and reads a single message, and returns its address and
+// the channel with the result of the read.
+func newServer(t *testing.T) (string, chan error) {
+	t.Helper()
+
+	result := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Upgrade(w, r)
+		if err != nil {
+			result <- err
+			return
+		}
+		defer conn.Close()
+
+		_, err = conn.ReadMessage()
+		result <- err
+	}))
+	t.Cleanup(server.Close)
+
+	return server.Listener.Addr().String(), result
+}
+
+// handshake writes the opening handshake with the given protocol version to the connection, and returns the reader of
+// the connection and the status of the response.
+func handshake(t *testing.T, conn net.Conn, version string) (*bufio.Reader, int) {
+	t.Helper()
+
+	request := "GET / HTTP/1.1\r\n" +
+		"Host: localhost\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: " + version + "\r\n\r\n"
+
+	if _, err := io.WriteString(conn, request); err != nil {
+		t.Fatalf("failed to write handshake: %v", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, &http.Request{Method: http.MethodGet})
+	if err != nil {
+		t.Fatalf("failed to read handshake: %v", err)
+	}
+	response.Body.Close()
+
+	return reader, response.StatusCode
+}
+
+func TestUpgradeVersion(t *testing.T) {
+	addr, result := newServer(t)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	if _, status := handshake(t, conn, "8"); status != http.StatusUpgradeRequired {
+		t.Errorf("expected status %d, got %d", http.StatusUpgradeRequired, status)
+	}
+	if err = <-result; err == nil {
+		t.Error("expected the upgrade to fail")
+	}
+}
+
+func TestRejectUnmaskedClientFrame(t *testing.T) {
+	addr, result := newServer(t)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	reader, status := handshake(t, conn, "13")
+	if status != http.StatusSwitchingProtocols {
+		t.Fatalf("expected status %d, got %d", http.StatusSwitchingProtocols, status)
+	}
+
+	// Write an unmasked final text frame.
+	if _, err = conn.Write([]byte{0x81, 0x02, 'h', 'i'}); err != nil {
+		t.Fatalf("failed to write frame: %v", err)
+	}
+
+	if err = <-result; !errors.Is(err, websocket.ErrProtocol) {
+		t.Errorf("expected a protocol error, got %v", err)
+	}
+
+	// The server answers with a close frame with the protocol error status.
+	frame := make([]byte, 4)
+	if _, err = io.ReadFull(reader, frame); err != nil {
+		t.Fatalf("failed to read close frame: %v", err)
+	}
+	if frame[0] != 0x88 || frame[1] != 0x02 || frame[2] != 0x03 || frame[3] != 0xea {
+		t.Errorf("expected a close frame with status 1002, got %x", frame)
+	}
+}
+
+// clientFrame returns a client frame with the given final flag, opcode and payload, masked with a zero masking key.
+func clientFrame(fin bool, opcode byte, payload []byte) []byte {
+	header := opcode
+	if fin {
+		header |= 0x80
+	}
+
+	frame := []byte{header}
+	if len(payload) < 126 {
+		frame = append(frame, 0x80|byte(len(payload)))
+	} else {
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	}
+	frame = append(frame, 0, 0, 0, 0)
+
+	return append(frame, payload...)
+}
+
+func TestRejectInvalidFrames(t *testing.T) {
+	tests := []struct {
+		name   string
+		frames [][]byte
+	}{
+		{
+			name:   "continuation without message",
+			frames: [][]byte{clientFrame(true, 0x0, []byte("hi"))},
+		},
+		{
+			name:   "text inside fragmented message",
+			frames: [][]byte{clientFrame(false, 0x1, []byte("h")), clientFrame(true, 0x1, []byte("i"))},
+		},
+		{
+			name:   "fragmented ping",
+			frames: [][]byte{clientFrame(false, 0x9, nil)},
+		},
+		{
+			name:   "ping payload too large",
+			frames: [][]byte{clientFrame(true, 0x9, make([]byte, 126))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, result := newServer(t)
+
+			conn, err := net.Dial("tcp", addr)
+			if err != nil {
+				t.Fatalf("failed to dial: %v", err)
+			}
+			defer conn.Close()
+
+			if _, status := handshake(t, conn, "13"); status != http.StatusSwitchingProtocols {
+				t.Fatalf("expected status %d, got %d", http.StatusSwitchingProtocols, status)
+			}
+
+			for _, frame := range tt.frames {
+				if _, err = conn.Write(frame); err != nil {
+					t.Fatalf("failed to write frame: %v", err)
+				}
+			}
+
+			if err = <-result; !errors.Is(err, websocket.ErrProtocol) {
+				t.Errorf("expected a protocol error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFragmentedMessage(t *testing.T) {
+	addr, result := newServer(t)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	if _, status := handshake(t, conn, "13"); status != http.StatusSwitchingProtocols {
+		t.Fatalf("expected status %d, got %d", http.StatusSwitchingProtocols, status)
+	}
+
+	// Interleave a ping between the fragments of a text message.
+	for _, frame := range [][]byte{
+		clientFrame(false, 0x1, []byte("h")),
+		clientFrame(true, 0x9, nil),
+		clientFrame(true, 0x0, []byte("i")),
+	} {
+		if _, err = conn.Write(frame); err != nil {
+			t.Fatalf("failed to write frame: %v", err)
+		}
+	}
+
+	if err = <-result; err != nil {
+		t.Errorf("expected the fragmented message to be read, got %v", err)
+	}
+}
+
+func TestReadDeadline(t *testing.T) {
+	addr, _ := newServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := websocket.Dial(ctx, "ws://"+addr)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	// The server never writes, so the read fails once the deadline is exceeded.
+	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	if _, err = conn.ReadMessage(); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("expected the read deadline to be exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+import type { Point } from './game-state';
+
+/**
+ * Represents the internal state of the jump behaviour.
+ */
+export interface JumpDebug {
+	canJump: boolean;
+	charge: number;
+	accumulatedImpulse: number;
+	usedImpulse: number;
+	overcharged: boolean;
+	bufferBeforeJump: string[] | null;
+	bufferAfterJump: string[] | null;
+}
+
+/**
+ * Represents the internal state of the fall behaviour.
+ */
+export interface FallDebug {
+	timer: number;
+	stunTimer: number;
+}
+
+/**
+ * Represents the internal state of the animator behaviour.
+ */
+export interface AnimationDebug {
+	name: string;
+	frame: number;
+	timer: number;
+}
+
+/**
+ * Represents a collision of a player with another object.
+ */
+export interface CollisionDebug {
+	playerId: number;
+
+	/**
+	 * Physics step, since the start of the run, in which the collision occurred.
+	 */
+	step: number;
+	otherId: number;
+	otherTag: string;
+
+	/**
+	 * Indicates whether the collision started or ended.
+	 */
+	enter: boolean;
+	contactPoints: Point[] | null;
+
+	/**
+	 * Normal of the surface of the other object, computed from the bounds of the objects.
+	 */
+	normal: Point;
+}
+
+/**
+ * Represents the internal state of the behaviours of a player.
+ */
+export interface PlayerDebug {
+	id: number;
+	grounds: number[];
+	ceilings: number[];
+	knockBackPlatforms: number[];
+	jump: JumpDebug;
+	fall: FallDebug;
+	animation: AnimationDebug;
+
+	/**
+	 * Recent collisions, from the oldest to the newest.
+	 */
+	collisions: CollisionDebug[];
+}
+
+/**
+ * Represents the internal state of the camera controller.
+ */
+export interface CameraDebug {
+	mode: string;
+	level: number;
+	transition: number;
+	previousPosition: Point;
+	currentPosition: Point;
+	trauma: number;
+	shakeOffset: Point;
+}
+
+/**
+ * Represents the internal state of the behaviours and physics of the game world.
+ *
+ * If an error occurs, `error` will contain an error message.
+ */
+export interface DebugState {
+	/**
+	 * Error message.
+	 */
+	error: string | null;
+	players: PlayerDebug[] | null;
+	camera: CameraDebug;
+}
@@ -1,5 +1,7 @@
 import type { Actions } from './actions';
-import type { GameState } from './game-state';
+import type { CollisionDebug, DebugState } from './debug';
+import type { ErrorResponse, GameState } from './game-state';
+import type { Trajectory } from './trajectory';
 import type { Version } from './version';
 
 /**
@@ -20,4 +22,184 @@ export interface Engine {
 	 * @returns Game state.
 	 */
 	step(actions: Actions): GameState;
+
+	/**
+	 * Replaces the ghost runs rendered alongside the players.
+	 *
+	 * @param traces JSON string with the list of ghost traces.
+	 * @returns Error response.
+	 */
+	loadGhosts(traces: string): ErrorResponse;
+
+	/**
+	 * Updates the opponents of a race rendered as ghosts alongside the players.
+	 *
+	 * @param opponents JSON string with the list of opponents, as sent by the race server.
+	 * @returns Error response.
+	 */
+	setOpponents(opponents: string): ErrorResponse;
+
+	/**
+	 * Enables or disables the camera shake, for accessibility.
+	 *
+	 * @param enabled Whether the camera shakes.
+	 * @returns Error response.
+	 */
+	setCameraShake(enabled: boolean): ErrorResponse;
+
+	/**
+	 * Resizes the camera to the given viewport size.
+	 *
+	 * @param width Viewport width in pixels.
+	 * @param height Viewport height in pixels.
+	 * @returns Error response.
+	 */
+	resize(width: number, height: number): ErrorResponse;
+
+	/**
+	 * Retrieves the internal state of the behaviours and physics of the game world.
+	 *
+	 * @returns Debug state.
+	 */
+	debug(): DebugState;
+
+	/**
+	 * Pauses the game. While paused, the game steps do not advance the game world.
+	 *
+	 * @returns Error response.
+	 */
+	pause(): ErrorResponse;
+
+	/**
+	 * Resumes the game. The time elapsed while paused is discarded.
+	 *
+	 * @returns Error response.
+	 */
+	resume(): ErrorResponse;
+
+	/**
+	 * Sets the scale applied to the elapsed time of the game steps.
+	 *
+	 * @param timeScale Time scale (e.g. 0.5 for half speed).
+	 * @returns Error response.
+	 */
+	setTimeScale(timeScale: number): ErrorResponse;
+
+	/**
+	 * Advances the game world by exactly the given number of physics updates, independently of the game timing.
+	 * Meant to be used while paused, so that the game world only advances on request.
+	 *
+	 * @param n Number of physics updates.
+	 * @param actions User actions in the game.
+	 * @returns Game state and the collisions that occurred.
+	 */
+	stepFixed(
+		n: number,
+		actions: Actions,
+	): GameState & { collisions: CollisionDebug[] };
+
+	/**
+	 * Performs a single update pass without advancing the physics.
+	 *
+	 * @returns Game state and the collisions that occurred.
+	 */
+	stepFrame(): GameState & { collisions: CollisionDebug[] };
+
+	/**
+	 * Predicts the arc of a jump of a player from its current position, until the first landing.
+	 *
+	 * @param player Index of the player.
+	 * @param charge Jump charge, in the range [0; 1].
+	 * @param direction Direction held when the jump is released, or empty for a vertical jump.
+	 * @returns Predicted trajectory.
+	 */
+	predictJump(
+		player: number,
+		charge: number,
+		direction: 'Left' | 'Right' | '',
+	): Trajectory;
+
+	/**
+	 * Enables or disables the practice mode.
+	 * Once enabled, the current run is excluded from the records.
+	 *
+	 * @param enabled Whether the practice mode is enabled.
+	 * @returns Error response.
+	 */
+	setPracticeMode(enabled: boolean): ErrorResponse;
+
+	/**
+	 * Moves a player to a position in world space at rest. Requires the practice mode.
+	 *
+	 * @param player Index of the player.
+	 * @param x Position on the x-axis.
+	 * @param y Position on the y-axis.
+	 * @returns Error response.
+	 */
+	teleport(player: number, x: number, y: number): ErrorResponse;
+
+	/**
+	 * Moves a player to the center of a screen level at rest. Requires the practice mode.
+	 *
+	 * @param player Index of the player.
+	 * @param level Screen level, starting at 0.
+	 * @returns Error response.
+	 */
+	teleportLevel(player: number, level: number): ErrorResponse;
+
+	/**
+	 * Saves the state of a player in a named bookmark. Requires the practice mode.
+	 *
+	 * @param player Index of the player.
+	 * @param name Name of the bookmark.
+	 * @returns Error response.
+	 */
+	saveBookmark(player: number, name: string): ErrorResponse;
+
+	/**
+	 * Restores the state of a player from a named bookmark. Requires the practice mode.
+	 *
+	 * @param player Index of the player.
+	 * @param name Name of the bookmark.
+	 * @returns Error response.
+	 */
+	loadBookmark(player: number, name: string): ErrorResponse;
+
+	/**
+	 * Places a tile in a layer of the map, replacing any tile already in that position.
+	 *
+	 * @param layer Name of the layer.
+	 * @param x Position on the x-axis of the tile on the map.
+	 * @param y Position on the y-axis of the tile on the map.
+	 * @param id Identifier of the tile.
+	 * @returns Error response.
+	 */
+	setTile(layer: string, x: number, y: number, id: string): ErrorResponse;
+
+	/**
+	 * Removes a tile from a layer of the map.
+	 *
+	 * @param layer Name of the layer.
+	 * @param x Position on the x-axis of the tile on the map.
+	 * @param y Position on the y-axis of the tile on the map.
+	 * @returns Error response.
+	 */
+	removeTile(layer: string, x: number, y: number): ErrorResponse;
+
+	/**
+	 * Exports the current map, including the edited tiles.
+	 *
+	 * @param format Format of the exported map: the map configuration or the Sprite Fusion project.
+	 * @returns Exported map as JSON.
+	 */
+	exportMap(
+		format: 'config' | 'spritefusion',
+	): ErrorResponse & { data: string };
+
+	/**
+	 * Exports the replay of the current run, to be submitted to the replay verifier.
+	 *
+	 * @returns Exported replay as JSON.
+	 */
+	exportReplay(): ErrorResponse & { data: string };
 }
@@ -116,6 +116,11 @@ export interface Renderer {
 	 * Indicates whether the player should be flipped horizontally or not.
 	 */
 	flipHorizontally: boolean | null;
+
+	/**
+	 * Opacity, in the range [0; 1], used to render the game object, or null if it is opaque.
+	 */
+	opacity: number | null;
 }
 
 /**
@@ -126,6 +131,31 @@ export enum GameObjectSound {
 	KNOCK_BACK = 'knockBack',
 	LANDING = 'landing',
 	FALL = 'fall',
+	BONK = 'bonk',
+}
+
+/**
+ * Defines the game object events.
+ */
+export enum GameObjectEvent {
+	BONK = 'bonk',
+	FALL = 'fall',
+	KNOCK_BACK = 'knockBack',
+}
+
+/**
+ * Represents debug information of a game object.
+ */
+export interface GameObjectDebug {
+	/**
+	 * Name of the gravity region the game object is in.
+	 */
+	gravityRegion: string | null;
+
+	/**
+	 * Indicates whether the game object is falling at terminal velocity.
+	 */
+	terminalVelocity: boolean | null;
 }
 
 /**
@@ -170,6 +200,16 @@ export interface GameObject {
 	 * Sounds associated with the current state of the game object.
 	 */
 	sounds: GameObjectSound[];
+
+	/**
+	 * Events that occurred with the game object in the current state.
+	 */
+	events: GameObjectEvent[];
+
+	/**
+	 * Debug information of the game object. Only defined for players.
+	 */
+	debug?: GameObjectDebug;
 }
 
 /**
@@ -209,6 +249,60 @@ export interface Camera {
 	ppu: number;
 }
 
+/**
+ * Defines on which axes a parallax layer image is repeated.
+ */
+export enum ParallaxRepeat {
+	NONE = 'none',
+	X = 'x',
+	Y = 'y',
+	BOTH = 'both',
+}
+
+/**
+ * Represents a parallax layer in screen space.
+ */
+export interface ParallaxLayer {
+	/**
+	 * Image asset of the layer.
+	 */
+	image: string;
+
+	/**
+	 * Position of the top left corner of the layer image in pixels.
+	 * When repeated, it is the position of the first image that is visible on the screen.
+	 */
+	position: Point;
+
+	/**
+	 * Size of the layer image in pixels.
+	 */
+	size: Point;
+
+	/**
+	 * Axes on which the layer image is repeated.
+	 */
+	repeat: ParallaxRepeat;
+
+	/**
+	 * Render order of the layer.
+	 * Layers with a negative order are drawn behind the game objects, and the others in front of them.
+	 */
+	order: number;
+}
+
+/**
+ * Represents the response of an engine method that does not return data.
+ *
+ * If an error occurs, `error` will contain an error message.
+ */
+export interface ErrorResponse {
+	/**
+	 * Error message.
+	 */
+	error: string | null;
+}
+
 /**
  * Represents the state of the game.
  * Includes the camera and the game objects in the world.
@@ -230,4 +324,31 @@ export interface GameState {
 	 * Viewpoint through which the player views the game world.
 	 */
 	camera: Camera;
+
+	/**
+	 * Parallax layers sorted by render order.
+	 */
+	parallax: ParallaxLayer[];
+
+	/**
+	 * Indicates whether the game is paused.
+	 */
+	paused: boolean;
+
+	/**
+	 * Fraction, in the range [0; 1), of the next physics update that has elapsed.
+	 * The positions of the interpolated game objects are already interpolated with it.
+	 */
+	alpha: number;
+
+	/**
+	 * Indicates whether the practice mode is enabled.
+	 */
+	practice: boolean;
+
+	/**
+	 * Indicates whether the current run can be submitted to the records.
+	 * It is not the case once the practice mode was enabled.
+	 */
+	recordable: boolean;
 }
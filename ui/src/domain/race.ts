@@ -0,0 +1,62 @@
+import type { ActionType } from './actions';
+import type { Point } from './game-state';
+
+/**
+ * Represents the state of a player in a race, in world space.
+ */
+export interface RacePlayer {
+	/**
+	 * Identifier of the player assigned by the race server.
+	 */
+	id: number;
+
+	/**
+	 * Name of the player.
+	 */
+	name: string;
+
+	/**
+	 * Position of the player.
+	 */
+	position: Point;
+
+	/**
+	 * Velocity of the player.
+	 */
+	velocity: Point;
+
+	/**
+	 * Current image of the player.
+	 */
+	image: string;
+
+	/**
+	 * Indicates whether the image is flipped horizontally.
+	 */
+	flipHorizontally: boolean;
+
+	/**
+	 * Height climbed from the spawn position.
+	 */
+	height: number;
+
+	/**
+	 * Maximum height climbed from the spawn position.
+	 */
+	maxHeight: number;
+}
+
+/**
+ * Represents the messages sent by the client to the race server.
+ */
+export type RaceClientMessage =
+	| { type: 'join'; room: string; name: string }
+	| { type: 'actions'; actions: Record<ActionType, boolean> };
+
+/**
+ * Represents the messages sent by the race server to the client.
+ */
+export type RaceServerMessage =
+	| { type: 'joined'; id: number }
+	| { type: 'state'; tick: number; players: RacePlayer[] }
+	| { type: 'error'; error: string };
@@ -3,6 +3,7 @@
  */
 export enum GameObjectTag {
 	PLAYER = 'Player',
+	GHOST = 'Ghost',
 	PLATFORM = 'Platform',
 	PROPS_BACKGROUND = 'Props-Background',
 	PROPS_FOREGROUND = 'Props-Foreground',
@@ -14,6 +15,7 @@ export enum GameObjectTag {
 export const GameObjectTagOrder = [
 	GameObjectTag.PLATFORM,
 	GameObjectTag.PROPS_BACKGROUND,
+	GameObjectTag.GHOST,
 	GameObjectTag.PLAYER,
 	GameObjectTag.PROPS_FOREGROUND,
 ];
@@ -0,0 +1,43 @@
+import type { ErrorResponse, Point } from './game-state';
+
+/**
+ * Represents the predicted arc of a jump.
+ *
+ * If an error occurs, `error` will contain an error message.
+ */
+export interface Trajectory extends ErrorResponse {
+	/**
+	 * Positions of the player after each physics update of the jump, in world space.
+	 */
+	points: Point[];
+
+	/**
+	 * Position where the player lands, in world space.
+	 */
+	landing: Point;
+
+	/**
+	 * Positions of the player after each physics update of the jump, in screen space.
+	 */
+	screenPoints: Point[];
+
+	/**
+	 * Position where the player lands, in screen space.
+	 */
+	screenLanding: Point;
+
+	/**
+	 * Indicates whether the player lands within the simulated duration.
+	 */
+	landed: boolean;
+
+	/**
+	 * Number of physics updates until the player lands.
+	 */
+	ticks: number;
+
+	/**
+	 * Number of times the player bounces off a wall.
+	 */
+	knockBacks: number;
+}
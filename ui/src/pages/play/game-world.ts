@@ -2,8 +2,10 @@ import type { Actions } from '../../domain/actions';
 import type { ImageBySource } from '../../domain/image';
 import type { Engine } from '../../domain/engine';
 import {
+	ParallaxRepeat,
 	type Camera,
 	type GameObject,
+	type ParallaxLayer,
 	type Point,
 } from '../../domain/game-state';
 import DebugTools from './utils/debug-tools';
@@ -53,15 +55,54 @@ class GameWorld {
 		this.#ctx.drawImage(img, offset.x, offset.y, width, height);
 	}
 
+	/**
+	 * Draws a parallax layer, repeating its image on the configured axes until the camera is covered.
+	 * @param layer Parallax layer.
+	 * @param camera Game camera.
+	 */
+	#drawParallaxLayer(layer: ParallaxLayer, camera: Camera) {
+		const img = this.#animator[layer.image];
+		const { position, size, repeat } = layer;
+
+		if (!img || size.x <= 0 || size.y <= 0) {
+			return;
+		}
+
+		const repeatX =
+			repeat === ParallaxRepeat.X || repeat === ParallaxRepeat.BOTH;
+		const repeatY =
+			repeat === ParallaxRepeat.Y || repeat === ParallaxRepeat.BOTH;
+		const endX = repeatX ? camera.width : position.x + size.x;
+		const endY = repeatY ? camera.height : position.y + size.y;
+
+		for (let x = position.x; x < endX; x += size.x) {
+			for (let y = position.y; y < endY; y += size.y) {
+				this.#ctx.drawImage(img, x, y, size.x, size.y);
+			}
+		}
+	}
+
 	/**
 	 * Draws the object visible to the camera.
 	 * @param gameObjects Game objects to draw.
 	 * @param camera Game camera.
+	 * @param parallax Parallax layers sorted by render order.
 	 */
-	#draw(gameObjects: GameObject[], camera: Camera) {
+	#draw(
+		gameObjects: GameObject[],
+		camera: Camera,
+		parallax: ParallaxLayer[],
+	) {
 		this.#ctx.canvas.width = camera.width;
 		this.#ctx.canvas.height = camera.height;
 
+		// Draw the background parallax layers.
+		for (const layer of parallax) {
+			if (layer.order < 0) {
+				this.#drawParallaxLayer(layer, camera);
+			}
+		}
+
 		// Sort game objects based on the configured tag order.
 		gameObjects.sort(
 			(a, b) =>
@@ -93,6 +134,10 @@ class GameWorld {
 				this.#ctx.scale(-1, 1);
 			}
 
+			if (renderer.opacity !== null) {
+				this.#ctx.globalAlpha = renderer.opacity;
+			}
+
 			if (renderer.image) {
 				this.#drawImage(
 					renderer.image,
@@ -122,6 +167,13 @@ class GameWorld {
 				);
 			}
 		}
+
+		// Draw the foreground parallax layers.
+		for (const layer of parallax) {
+			if (layer.order >= 0) {
+				this.#drawParallaxLayer(layer, camera);
+			}
+		}
 	}
 
 	/**
@@ -129,14 +181,15 @@ class GameWorld {
 	 * @param actions Actions to perform.
 	 */
 	step(actions: Actions) {
-		const { error, gameObjects, camera } = this.#engine.step(actions);
+		const { error, gameObjects, camera, parallax } =
+			this.#engine.step(actions);
 
 		if (error) {
 			console.error(error);
 			return;
 		}
 
-		this.#draw(gameObjects, camera);
+		this.#draw(gameObjects, camera, parallax);
 	}
 
 	/**
@@ -13,6 +13,7 @@ import useActions from './use-actions';
 import type { ImageBySource } from '../../domain/image';
 import { loadAnimator } from './utils/animator';
 import SoundButton from './sound';
+import { connectRace } from './utils/race-client';
 
 function Canvas({
 	engine,
@@ -35,16 +36,56 @@ function Canvas({
 		}
 
 		const gameWorld = new GameWorld(ctx, engine, animator, muted());
+		const race = connectRace();
+
+		function resize() {
+			const { error } = engine.resize(window.innerWidth, window.innerHeight);
+
+			if (error) {
+				console.error(error);
+			}
+		}
+
+		function pause() {
+			const { error } = document.hidden ? engine.pause() : engine.resume();
+
+			if (error) {
+				console.error(error);
+			}
+		}
+
+		resize();
+		window.addEventListener('resize', resize);
+		document.addEventListener('visibilitychange', pause);
 
 		let frame = requestAnimationFrame(step);
 
 		function step() {
 			frame = requestAnimationFrame(step);
 			gameWorld.muted = muted();
+
+			if (race) {
+				race.sendActions(actions());
+
+				const opponents = race.takeOpponents();
+				if (opponents !== null) {
+					const { error } = engine.setOpponents(opponents);
+
+					if (error) {
+						console.error(error);
+					}
+				}
+			}
+
 			gameWorld.step(Object.entries(actions()) as Actions);
 		}
 
-		onCleanup(() => cancelAnimationFrame(frame));
+		onCleanup(() => {
+			cancelAnimationFrame(frame);
+			race?.close();
+			window.removeEventListener('resize', resize);
+			document.removeEventListener('visibilitychange', pause);
+		});
 	});
 
 	return <canvas ref={canvas} class="border-2 border-blue-400" />;
@@ -49,7 +49,7 @@ class DebugTools {
 		ctx.fillStyle = DebugTools.#FILL_STYLE;
 		ctx.font = DebugTools.#FONT;
 
-		const { transform, rigidBody, renderer } = gameObject;
+		const { transform, rigidBody, renderer, debug } = gameObject;
 
 		ctx.translate(
 			debugPosition.x + DebugTools.#OFFSET.x,
@@ -66,13 +66,21 @@ class DebugTools {
 		];
 
 		if (rigidBody) {
-			const { mass, velocity, angularVelocity, drag, angularDrag } = rigidBody;
+			const {
+				mass,
+				velocity,
+				angularVelocity,
+				drag,
+				angularDrag,
+				gravityScale,
+			} = rigidBody;
 			info.push(
 				`M: ${mass}`,
 				`Vx: ${velocity.x} Vy: ${velocity.y}`,
 				`AV: ${angularVelocity}`,
 				`D: ${drag}`,
 				`AD: ${angularDrag}`,
+				`GS: ${gravityScale}`,
 			);
 		}
 
@@ -81,6 +89,14 @@ class DebugTools {
 			info.push(`H: ${height} W: ${width}`);
 		}
 
+		if (debug) {
+			const { gravityRegion, terminalVelocity } = debug;
+			info.push(
+				`GR: ${gravityRegion ?? '-'}`,
+				`TV: ${terminalVelocity ?? false}`,
+			);
+		}
+
 		DebugTools.#renderMultilineText(ctx, info);
 
 		ctx.restore();
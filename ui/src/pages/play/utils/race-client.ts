@@ -0,0 +1,131 @@
+import type { ActionType } from '../../../domain/actions';
+import type {
+	RaceClientMessage,
+	RacePlayer,
+	RaceServerMessage,
+} from '../../../domain/race';
+
+/**
+ * Represents the connection to a race server, which streams the actions of the player and receives the state of the
+ * opponents.
+ */
+class RaceClient {
+	#socket: WebSocket;
+
+	#id: number | null = null;
+
+	#opponents: RacePlayer[] = [];
+
+	#updated = false;
+
+	#actions = '';
+
+	/**
+	 * Connects to the race server and joins a room.
+	 * @param url WebSocket URL of the race server.
+	 * @param room Name of the room.
+	 * @param name Name of the player.
+	 */
+	constructor(url: string, room: string, name: string) {
+		this.#socket = new WebSocket(url);
+
+		this.#socket.addEventListener('open', () => {
+			this.#send({ type: 'join', room, name });
+		});
+
+		this.#socket.addEventListener('message', e => {
+			this.#receive(JSON.parse(e.data) as RaceServerMessage);
+		});
+
+		this.#socket.addEventListener('close', () => {
+			this.#opponents = [];
+			this.#updated = true;
+		});
+	}
+
+	/**
+	 * Sends the current state of the actions of the player, if they changed since the last time they were sent.
+	 * @param actions State of the actions.
+	 */
+	sendActions(actions: Record<ActionType, boolean>) {
+		const data = JSON.stringify(actions);
+
+		if (this.#id === null || data === this.#actions) {
+			return;
+		}
+
+		this.#actions = data;
+		this.#send({ type: 'actions', actions });
+	}
+
+	/**
+	 * Retrieves the opponents received since the last call, if any.
+	 * @returns JSON string with the list of opponents, or null if they did not change.
+	 */
+	takeOpponents() {
+		if (!this.#updated) {
+			return null;
+		}
+
+		this.#updated = false;
+
+		return JSON.stringify(this.#opponents);
+	}
+
+	/**
+	 * Closes the connection to the race server.
+	 */
+	close() {
+		this.#socket.close();
+	}
+
+	/**
+	 * Sends a message to the race server.
+	 * @param message Client message.
+	 */
+	#send(message: RaceClientMessage) {
+		if (this.#socket.readyState === WebSocket.OPEN) {
+			this.#socket.send(JSON.stringify(message));
+		}
+	}
+
+	/**
+	 * Handles a message received from the race server.
+	 * @param message Server message.
+	 */
+	#receive(message: RaceServerMessage) {
+		switch (message.type) {
+			case 'joined':
+				this.#id = message.id;
+				break;
+			case 'state':
+				this.#opponents = message.players.filter(p => p.id !== this.#id);
+				this.#updated = true;
+				break;
+			case 'error':
+				console.error(message.error);
+				break;
+		}
+	}
+}
+
+/**
+ * Connects to the race server defined in the query parameters of the page, if any.
+ * @returns Race client, or null if the page is not opened in a race.
+ */
+export function connectRace() {
+	const params = new URLSearchParams(window.location.search);
+	const url = params.get('race');
+
+	if (!url) {
+		return null;
+	}
+
+	return new RaceClient(
+		url,
+		params.get('room') ?? 'lobby',
+		params.get('name') ?? 'player',
+	);
+}
+
+export default RaceClient;
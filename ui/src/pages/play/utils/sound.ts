@@ -8,6 +8,7 @@ export const SOUND_SOURCES = {
 	[GameObjectSound.KNOCK_BACK]: new Audio('sounds/knockBack.ogg'),
 	[GameObjectSound.LANDING]: new Audio('sounds/landing.ogg'),
 	[GameObjectSound.FALL]: new Audio('sounds/fall.ogg'),
+	[GameObjectSound.BONK]: new Audio('sounds/knockBack.ogg'),
 };
 
 /**